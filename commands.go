@@ -0,0 +1,307 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"greekOrtho/internal/data"
+	"greekOrtho/internal/display"
+	"greekOrtho/internal/models"
+	"greekOrtho/internal/pascha"
+	"os"
+	"strings"
+	"time"
+)
+
+// runPaschalion implements the "paschalion" subcommand.
+func runPaschalion(args []string) {
+	fs := flag.NewFlagSet("paschalion", flag.ExitOnError)
+	thisYear := time.Now().Year()
+	fromFlag := fs.Int("from", thisYear, "First year of the table")
+	toFlag := fs.Int("to", thisYear+9, "Last year of the table")
+	fs.Parse(args)
+
+	if *fromFlag < 1 || *toFlag < *fromFlag {
+		fmt.Fprintf(os.Stderr, "Error: invalid year range %d–%d\n", *fromFlag, *toFlag)
+		os.Exit(1)
+	}
+
+	entries := make([]pascha.Paschalion, 0, *toFlag-*fromFlag+1)
+	for year := *fromFlag; year <= *toFlag; year++ {
+		entries = append(entries, pascha.Keys(year))
+	}
+	display.PrintPaschalion(entries)
+}
+
+// runWeddings implements the "weddings" subcommand.
+func runWeddings(args []string) {
+	fs := flag.NewFlagSet("weddings", flag.ExitOnError)
+	fromFlag := fs.String("from", "", "First date in YYYY-MM-DD format (defaults to today)")
+	toFlag := fs.String("to", "", "Last date in YYYY-MM-DD format (defaults to 90 days after -from)")
+	loadCalendar := calendarFlags(fs)
+	fs.Parse(args)
+
+	from := models.Today()
+	if *fromFlag != "" {
+		var err error
+		from, err = models.ParseDate(*fromFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid date format %q (use YYYY-MM-DD)\n", *fromFlag)
+			os.Exit(1)
+		}
+	}
+	to := from.AddDays(90)
+	if *toFlag != "" {
+		var err error
+		to, err = models.ParseDate(*toFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid date format %q (use YYYY-MM-DD)\n", *toFlag)
+			os.Exit(1)
+		}
+	}
+	if to.Before(from) {
+		fmt.Fprintf(os.Stderr, "Error: invalid date range %s–%s\n", from, to)
+		os.Exit(1)
+	}
+
+	cal := loadCalendar()
+	display.PrintWeddings(cal.Range(from, to))
+}
+
+// runProfiles implements the "profiles" subcommand: with no argument it lists
+// the jurisdiction profiles, and with a profile name it shows what it changes.
+func runProfiles(args []string) {
+	fs := flag.NewFlagSet("profiles", flag.ExitOnError)
+	fs.Parse(args)
+
+	profiles, err := data.Profiles()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading profiles: %v\n", err)
+		os.Exit(1)
+	}
+
+	changes := make(map[string][]data.Change, len(profiles))
+	for _, p := range profiles {
+		if fs.NArg() > 0 && p.Name != fs.Arg(0) {
+			continue
+		}
+		cs, err := data.ProfileChanges(p.Name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading profile: %v\n", err)
+			os.Exit(1)
+		}
+		changes[p.Name] = cs
+	}
+
+	if fs.NArg() == 0 {
+		display.PrintProfiles(profiles, changes)
+		return
+	}
+	for _, p := range profiles {
+		if p.Name == fs.Arg(0) {
+			display.PrintProfileChanges(p, changes[p.Name])
+			return
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Error: unknown profile %q\n", fs.Arg(0))
+	os.Exit(1)
+}
+
+// runNameDay implements the "nameday" subcommand.
+func runNameDay(args []string) {
+	fs := flag.NewFlagSet("nameday", flag.ExitOnError)
+	yearFlag := fs.Int("year", models.Today().Year, "Year to list name days for")
+	loadCalendar := calendarFlags(fs)
+	fs.Parse(args)
+
+	// Allow flags after the name, e.g. "nameday George -year 2025".
+	name := fs.Arg(0)
+	if fs.NArg() > 1 {
+		fs.Parse(fs.Args()[1:])
+	}
+
+	cal := loadCalendar()
+	if name == "" {
+		display.PrintNameDayYear(cal.Range(models.NewDate(*yearFlag, time.January, 1), models.NewDate(*yearFlag, time.December, 31)))
+		return
+	}
+
+	nd, ok := cal.LookupNameDay(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: no name day found for %q\n", name)
+		os.Exit(1)
+	}
+	var days []models.DayInfo
+	for _, d := range cal.NameDayDates(nd, *yearFlag) {
+		days = append(days, cal.GetDayInfo(d))
+	}
+	display.PrintNameDay(nd, *yearFlag, days)
+}
+
+// runUpcoming implements the "upcoming" subcommand.
+func runUpcoming(args []string) {
+	fs := flag.NewFlagSet("upcoming", flag.ExitOnError)
+	fromFlag := fs.String("from", "", "First date in YYYY-MM-DD format (defaults to today)")
+	daysFlag := fs.Int("days", 7, "Number of days to list")
+	contactsFlag := fs.String("contacts", "", "Address book (.vcf or .csv) (defaults to contacts.vcf or contacts.csv in $XDG_CONFIG_HOME/orthoCal)")
+	jsonFlag := fs.Bool("json", false, "Print the listing as JSON")
+	loadCalendar := calendarFlags(fs)
+	fs.Parse(args)
+
+	if *daysFlag < 1 {
+		fmt.Fprintf(os.Stderr, "Error: -days must be at least 1, got %d\n", *daysFlag)
+		os.Exit(1)
+	}
+
+	from := models.Today()
+	if *fromFlag != "" {
+		var err error
+		from, err = models.ParseDate(*fromFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid date format %q (use YYYY-MM-DD)\n", *fromFlag)
+			os.Exit(1)
+		}
+	}
+
+	cal := loadCalendar()
+	unmatched := cal.SetContacts(loadContacts(*contactsFlag, true))
+	days := cal.Range(from, from.AddDays(*daysFlag-1))
+	if *jsonFlag {
+		display.PrintUpcomingJSON(days)
+		return
+	}
+	display.PrintUpcoming(days, len(unmatched))
+}
+
+// runFood implements the "food" subcommand.
+func runFood(args []string) {
+	fs := flag.NewFlagSet("food", flag.ExitOnError)
+	dateFlag := fs.String("date", "", "Date to check in YYYY-MM-DD format (defaults to today)")
+	daysFlag := fs.Int("days", 30, "Number of days, from the date, to list the permitted days of")
+	loadCalendar := calendarFlags(fs)
+	fastingFlag := fs.String("fasting", "", "Personal fasting profile (defaults to fasting.json in $XDG_CONFIG_HOME/orthoCal)")
+	fs.Parse(args)
+
+	// The name may be several words, with flags before or after it, e.g.
+	// "food olive oil -date 2027-03-10".
+	var words []string
+	for fs.NArg() > 0 {
+		words = append(words, fs.Arg(0))
+		fs.Parse(fs.Args()[1:])
+	}
+	name := strings.Join(words, " ")
+	if name == "" {
+		fmt.Fprintln(os.Stderr, "Error: name a food, e.g. \"food cheese\"")
+		os.Exit(1)
+	}
+
+	if *daysFlag < 1 {
+		fmt.Fprintf(os.Stderr, "Error: -days must be at least 1, got %d\n", *daysFlag)
+		os.Exit(1)
+	}
+
+	date := models.Today()
+	if *dateFlag != "" {
+		var err error
+		date, err = models.ParseDate(*dateFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid date format %q (use YYYY-MM-DD)\n", *dateFlag)
+			os.Exit(1)
+		}
+	}
+
+	cal := loadCalendar()
+	cal.SetFastingProfile(loadFastingProfile(*fastingFlag))
+	item, ok := cal.LookupFood(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: unknown food %q\n", name)
+		os.Exit(1)
+	}
+
+	end := date.AddDays(*daysFlag - 1)
+	display.PrintFoodVerdict(cal.CheckFood(item, date), cal.Style(), cal.PermittedDays(item, date, end), end)
+}
+
+// runFasts implements the "fasts" subcommand.
+func runFasts(args []string) {
+	fs := flag.NewFlagSet("fasts", flag.ExitOnError)
+	dateFlag := fs.String("date", "", "Date in YYYY-MM-DD format (defaults to today)")
+	jsonFlag := fs.Bool("json", false, "Print the fasts as JSON")
+	loadCalendar := calendarFlags(fs)
+	fs.Parse(args)
+
+	date := models.Today()
+	if *dateFlag != "" {
+		var err error
+		date, err = models.ParseDate(*dateFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid date format %q (use YYYY-MM-DD)\n", *dateFlag)
+			os.Exit(1)
+		}
+	}
+
+	cal := loadCalendar()
+	status, periods := cal.FastingStatus(date), cal.FastingPeriods(date.Year)
+	if *jsonFlag {
+		display.PrintFastsJSON(date, status, periods)
+		return
+	}
+	display.PrintFasts(date, cal.Style(), status, periods)
+}
+
+// runStats implements the "stats" subcommand.
+func runStats(args []string) {
+	fs := flag.NewFlagSet("stats", flag.ExitOnError)
+	yearFlag := fs.Int("year", models.Today().Year, "Year to report on")
+	fromFlag := fs.String("from", "", "First date in YYYY-MM-DD format, instead of a year")
+	toFlag := fs.String("to", "", "Last date in YYYY-MM-DD format (defaults to a year after -from)")
+	csvFlag := fs.Bool("csv", false, "Print the report as CSV")
+	jsonFlag := fs.Bool("json", false, "Print the report as JSON")
+	loadCalendar := calendarFlags(fs)
+	fs.Parse(args)
+
+	if *csvFlag && *jsonFlag {
+		fmt.Fprintln(os.Stderr, "Error: --csv and --json are mutually exclusive")
+		os.Exit(1)
+	}
+	yearSet := false
+	fs.Visit(func(f *flag.Flag) { yearSet = yearSet || f.Name == "year" })
+	if yearSet && (*fromFlag != "" || *toFlag != "") {
+		fmt.Fprintln(os.Stderr, "Error: --year and --from/--to are mutually exclusive")
+		os.Exit(1)
+	}
+
+	from := models.NewDate(*yearFlag, time.January, 1)
+	to := models.NewDate(*yearFlag, time.December, 31)
+	if *fromFlag != "" {
+		var err error
+		from, err = models.ParseDate(*fromFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid date format %q (use YYYY-MM-DD)\n", *fromFlag)
+			os.Exit(1)
+		}
+		to = models.NewDate(from.Year+1, from.Month, from.Day-1)
+	}
+	if *toFlag != "" {
+		var err error
+		to, err = models.ParseDate(*toFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid date format %q (use YYYY-MM-DD)\n", *toFlag)
+			os.Exit(1)
+		}
+	}
+	if to.Before(from) {
+		fmt.Fprintf(os.Stderr, "Error: invalid date range %s–%s\n", from, to)
+		os.Exit(1)
+	}
+
+	cal := loadCalendar()
+	stats := cal.FastingStats(from, to)
+	switch {
+	case *csvFlag:
+		display.PrintFastingStatsCSV(stats)
+	case *jsonFlag:
+		display.PrintFastingStatsJSON(stats)
+	default:
+		display.PrintFastingStats(stats, cal.Style())
+	}
+}
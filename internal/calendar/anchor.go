@@ -0,0 +1,48 @@
+package calendar
+
+import "greekOrtho/internal/models"
+
+// anchoredDate returns the civil date of the feast anchored by a to its fixed date
+// in the given year of the calendar style.
+func anchoredDate(a models.FeastAnchor, year int, style models.CalendarStyle) models.Date {
+	date := civilDate(year, a.Month, a.Day, style)
+	// Days from date forward to the next such weekday, 0 if date is one
+	ahead := (int(a.Weekday) - int(date.Weekday()) + 7) % 7
+
+	switch a.Relation {
+	case models.AnchorBefore:
+		if ahead == 0 {
+			return date.AddDays(-7)
+		}
+		return date.AddDays(ahead - 7)
+	case models.AnchorAfter:
+		if ahead == 0 {
+			return date.AddDays(7)
+		}
+		return date.AddDays(ahead)
+	default: // models.AnchorNearest
+		if ahead > 3 {
+			return date.AddDays(ahead - 7)
+		}
+		return date.AddDays(ahead)
+	}
+}
+
+// resolveAnchors returns the weekday-anchored feasts that fall in the civil year,
+// by date. Anchors in the neighbouring years are included because the Sunday
+// after the Nativity or before Theophany can cross the new year. When two fall
+// on the same day the one listed first is kept: with Christmas on a Sunday or
+// Monday, the Sunday after the Nativity is also the Sunday before Theophany,
+// which is then not kept that year.
+func resolveAnchors(year int, style models.CalendarStyle, feasts []models.Feast) map[models.Date][]models.Feast {
+	result := make(map[models.Date][]models.Feast)
+	for _, f := range feasts {
+		for _, y := range []int{year - 1, year, year + 1} {
+			date := anchoredDate(*f.Anchor, y, style)
+			if date.Year == year && len(result[date]) == 0 {
+				result[date] = append(result[date], f)
+			}
+		}
+	}
+	return result
+}
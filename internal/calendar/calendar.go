@@ -4,77 +4,266 @@ import (
 	"greekOrtho/internal/data"
 	"greekOrtho/internal/models"
 	"greekOrtho/internal/pascha"
-	"time"
+	"sync"
 )
 
 // Calendar provides methods to look up liturgical info for any date.
+// It is safe for concurrent use.
 type Calendar struct {
-	data *data.CalendarData
+	data  *data.CalendarData
+	style models.CalendarStyle
+
+	// Indexes built once by New and read-only afterwards.
+	fixedFeasts    map[int][]models.Feast      // month*100+day → feasts
+	moveableFeasts map[int][]models.Feast      // days from Pascha → feasts
+	anchoredFeasts []models.Feast              // feasts kept on a weekday around a fixed date
+	saints         map[int][]models.Saint      // month*100+day → saints
+	memorials      map[int][]models.Memorial   // days from Pascha → Saturdays of Souls
+	nameDays       map[string][]models.NameDay // commemoration name → name days
+	feastNames     map[string]bool             // names of fixed and moveable feasts
+	contacts       map[string][]models.Contact // name day name → contacts, set by SetContacts
+	fastingProfile *models.FastingProfile      // set by SetFastingProfile
+
+	// Feasts with a forefeast or afterfeast
+	fixedPeriodFeasts    []models.Feast
+	moveablePeriodFeasts []models.Feast
+
+	mu    sync.RWMutex
+	years map[int]*liturgicalYear // civil year → cached data, filled on demand
+}
+
+// liturgicalYear caches what depends only on the civil year and its Pascha.
+type liturgicalYear struct {
+	pascha         models.Date
+	transfers      []transfer
+	coincidences   []coincidence
+	anchored       map[models.Date][]models.Feast // weekday-anchored feasts by date
+	fastingPeriods []models.FastingPeriod
+}
+
+// New creates a new Calendar with the embedded data. The style selects whether
+// fixed feasts, saints and fixed fasting periods follow the civil (revised) or
+// Julian calendar; Pascha is computed the same way for both.
+func New(d *data.CalendarData, style models.CalendarStyle) *Calendar {
+	if style == "" {
+		style = models.StyleRevised
+	}
+	c := &Calendar{
+		data:           d,
+		style:          style,
+		fixedFeasts:    make(map[int][]models.Feast),
+		moveableFeasts: make(map[int][]models.Feast),
+		saints:         make(map[int][]models.Saint),
+		memorials:      make(map[int][]models.Memorial),
+		nameDays:       make(map[string][]models.NameDay),
+		feastNames:     make(map[string]bool),
+		years:          make(map[int]*liturgicalYear),
+	}
+
+	for _, f := range d.FixedFeasts {
+		if f.Month != nil && f.Day != nil {
+			key := dayKey(*f.Month, *f.Day)
+			c.fixedFeasts[key] = append(c.fixedFeasts[key], f)
+			if f.Forefeast > 0 || f.Afterfeast > 0 {
+				c.fixedPeriodFeasts = append(c.fixedPeriodFeasts, f)
+			}
+		}
+	}
+	for _, f := range d.MoveableFeasts {
+		if f.PaschaOffset != nil {
+			c.moveableFeasts[*f.PaschaOffset] = append(c.moveableFeasts[*f.PaschaOffset], f)
+			if f.Forefeast > 0 || f.Afterfeast > 0 {
+				c.moveablePeriodFeasts = append(c.moveablePeriodFeasts, f)
+			}
+		} else if f.Anchor != nil {
+			c.anchoredFeasts = append(c.anchoredFeasts, f)
+		}
+	}
+	for _, s := range d.Saints {
+		key := dayKey(s.Month, s.Day)
+		c.saints[key] = append(c.saints[key], s)
+	}
+	for _, m := range d.Memorials {
+		c.memorials[m.PaschaOffset] = append(c.memorials[m.PaschaOffset], m)
+	}
+	for _, f := range d.FixedFeasts {
+		c.feastNames[f.Name] = true
+	}
+	for _, f := range d.MoveableFeasts {
+		c.feastNames[f.Name] = true
+	}
+	for _, n := range d.NameDays {
+		for _, name := range n.Commemorations {
+			c.nameDays[name] = append(c.nameDays[name], n)
+		}
+	}
+
+	return c
+}
+
+// Style returns the calendar style fixed feasts follow.
+func (c *Calendar) Style() models.CalendarStyle {
+	return c.style
 }
 
-// New creates a new Calendar with the embedded data.
-func New(d *data.CalendarData) *Calendar {
-	return &Calendar{data: d}
+// dayKey returns the index key for a month and day.
+func dayKey(month, day int) int {
+	return month*100 + day
+}
+
+// year returns the cached Pascha, feast transfers, weekday-anchored feasts and
+// fasting periods for the given year, computing them once per year.
+func (c *Calendar) year(year int) *liturgicalYear {
+	c.mu.RLock()
+	ly, ok := c.years[year]
+	c.mu.RUnlock()
+	if ok {
+		return ly
+	}
+
+	ly = &liturgicalYear{pascha: pascha.Compute(year)}
+	ly.transfers, ly.coincidences = resolveTransfers(ly.pascha, c.style, c.data.TransferRules)
+	ly.anchored = resolveAnchors(year, c.style, c.anchoredFeasts)
+	ly.fastingPeriods = resolveFastingPeriods(year, ly.pascha, c.style, c.data.FastingRules)
+	c.mu.Lock()
+	c.years[year] = ly
+	c.mu.Unlock()
+	return ly
 }
 
 // GetDayInfo returns the complete liturgical information for a given date.
-func (c *Calendar) GetDayInfo(date time.Time) models.DayInfo {
-	p := pascha.Compute(date.Year())
+func (c *Calendar) GetDayInfo(date models.Date) models.DayInfo {
+	ly := c.year(date.Year)
+	p := ly.pascha
+	fixed := fixedDate(date, c.style)
 
-	feasts := c.findFeasts(date, p)
-	saints := c.findSaints(date)
-	fastingLevel, fastingReason := ResolveFasting(date, p, c.data.FastingRules, feasts)
-	readings := ResolveReadings(date, p, c.data, feasts)
+	feasts := c.findFeasts(date, fixed, ly)
+	saints := c.findSaints(date, fixed, ly)
+	nameDays := c.nameDaysOn(feasts, saints)
+	fastingLevel, fastingReason := ResolveFasting(date, p, c.style, c.data.FastingRules, feasts)
+	liturgy, liturgyNote := ResolveLiturgy(date, p, c.style)
+	kneeling, kneelingReason := ResolveKneeling(date, p, c.style, c.data.KneelingRules)
+	weddings, weddingReason := ResolveWeddings(date, p, c.style, c.data.WeddingRules, c.eveOf(date))
+	readings := resolveReadings(date, p, c.style, c.data, feasts, ly.transfers)
 	quote := c.selectQuote(date)
 
+	prev := c.year(date.Year - 1).pascha
+	eothinon := resolveEothinon(date, p, prev)
+	if matins := matinsReadings(eothinon, c.data); matins != nil {
+		readings = append(readings, *matins)
+	}
+
+	var personal *models.PersonalFast
+	if c.fastingProfile != nil {
+		pf := ResolvePersonalFast(date, fastingLevel, *c.fastingProfile)
+		personal = &pf
+	}
+
 	return models.DayInfo{
-		Date:          date,
-		Feasts:        feasts,
-		Saints:        saints,
-		FastingLevel:  fastingLevel,
-		FastingReason: fastingReason,
-		Readings:      readings,
-		Quote:         quote,
+		Date:           date,
+		JulianDate:     date.Julian(),
+		Style:          c.style,
+		Feasts:         feasts,
+		Saints:         saints,
+		NameDays:       nameDays,
+		Contacts:       c.contactsOn(nameDays),
+		FastingLevel:   fastingLevel,
+		FastingReason:  fastingReason,
+		PersonalFast:   personal,
+		FastingStatus:  c.periodStatus(date),
+		Coincidences:   findCoincidences(date, ly),
+		FeastPeriods:   c.findFeastPeriods(date, ly),
+		Memorials:      c.memorials[date.Sub(p)],
+		LiturgicalDay:  resolveLiturgicalDay(date, p, prev, c.style),
+		Liturgy:        liturgy,
+		LiturgyNote:    liturgyNote,
+		Weddings:       weddings,
+		WeddingReason:  weddingReason,
+		Kneeling:       kneeling,
+		KneelingReason: kneelingReason,
+		Tone:           resolveTone(date, p, prev),
+		Eothinon:       eothinon,
+		Readings:       readings,
+		Quote:          quote,
 	}
 }
 
-// findFeasts returns all feasts (fixed and moveable) that fall on the given date.
-func (c *Calendar) findFeasts(date time.Time, p time.Time) []models.Feast {
-	var result []models.Feast
+// SetFastingProfile sets the fasting profile whose personal fast GetDayInfo
+// reports next to the canonical one; nil clears it. Call it before the
+// Calendar is used concurrently.
+func (c *Calendar) SetFastingProfile(p *models.FastingProfile) {
+	c.fastingProfile = p
+}
 
-	for _, f := range c.data.FixedFeasts {
-		if f.Month != nil && f.Day != nil {
-			if int(date.Month()) == *f.Month && date.Day() == *f.Day {
-				result = append(result, f)
-			}
-		}
+// ExplainFasting traces how the fasting level of a given date is resolved.
+func (c *Calendar) ExplainFasting(date models.Date) models.FastingExplanation {
+	ly := c.year(date.Year)
+	feasts := c.findFeasts(date, fixedDate(date, c.style), ly)
+	return ResolveFastingExplained(date, ly.pascha, c.style, c.data.FastingRules, feasts)
+}
+
+// findFeasts returns all feasts (fixed, moveable and weekday-anchored) that fall on the given date
+// after transfer rules are applied. Fixed feasts are matched against fixed, the
+// date in the calendar's style.
+func (c *Calendar) findFeasts(date models.Date, fixed models.Date, ly *liturgicalYear) []models.Feast {
+	var result []models.Feast
+	if !transferredAway(ly.transfers, date) {
+		result = append(result, c.fixedFeasts[dayKey(int(fixed.Month), fixed.Day)]...)
 	}
 
-	daysFromPascha := int(date.Sub(p).Hours() / 24)
-	for _, f := range c.data.MoveableFeasts {
-		if f.PaschaOffset != nil {
-			if daysFromPascha == *f.PaschaOffset {
-				result = append(result, f)
-			}
+	daysFromPascha := date.Sub(ly.pascha)
+	result = append(result, c.moveableFeasts[daysFromPascha]...)
+	result = append(result, ly.anchored[date]...)
+
+	for _, t := range ly.transfers {
+		if t.to != date {
+			continue
+		}
+		from := t.from
+		for _, f := range c.fixedFeasts[dayKey(t.rule.Month, t.rule.Day)] {
+			f.TransferredFrom = &from
+			result = append(result, f)
 		}
 	}
 
 	return result
 }
 
-// findSaints returns all saints commemorated on the given date.
-func (c *Calendar) findSaints(date time.Time) []models.Saint {
+// eveOf returns the feasts of the day after date, for the rules that apply on
+// the eve of a feast.
+func (c *Calendar) eveOf(date models.Date) []models.Feast {
+	next := date.AddDays(1)
+	return c.findFeasts(next, fixedDate(next, c.style), c.year(next.Year))
+}
+
+// findCoincidences returns the notes of merge and coincidence rules that apply on date.
+func findCoincidences(date models.Date, ly *liturgicalYear) []string {
+	var notes []string
+	for _, co := range ly.coincidences {
+		if co.date == date && co.rule.Note != "" {
+			notes = append(notes, co.rule.Note)
+		}
+	}
+	return notes
+}
+
+// findSaints returns all saints commemorated on the given date, matched against
+// fixed as findFeasts does: the saints of a transferred date move with its feasts.
+func (c *Calendar) findSaints(date models.Date, fixed models.Date, ly *liturgicalYear) []models.Saint {
 	var result []models.Saint
-	for _, s := range c.data.Saints {
-		if int(date.Month()) == s.Month && date.Day() == s.Day {
-			result = append(result, s)
+	if !transferredAway(ly.transfers, date) {
+		result = append(result, c.saints[dayKey(int(fixed.Month), fixed.Day)]...)
+	}
+	for _, t := range ly.transfers {
+		if t.to == date {
+			result = append(result, c.saints[dayKey(t.rule.Month, t.rule.Day)]...)
 		}
 	}
 	return result
 }
 
 // selectQuote returns a deterministic quote for the given date (day-of-year modulo).
-func (c *Calendar) selectQuote(date time.Time) models.Quote {
+func (c *Calendar) selectQuote(date models.Date) models.Quote {
 	if len(c.data.Quotes) == 0 {
 		return models.Quote{Text: "Lord, have mercy.", Author: "The Church"}
 	}
@@ -4,7 +4,6 @@ import (
 	"greekOrtho/internal/data"
 	"greekOrtho/internal/models"
 	"testing"
-	"time"
 )
 
 func newCalendar(t *testing.T) *Calendar {
@@ -13,12 +12,12 @@ func newCalendar(t *testing.T) *Calendar {
 	if err != nil {
 		t.Fatalf("failed to load data: %v", err)
 	}
-	return New(d)
+	return New(d, models.StyleRevised)
 }
 
 func TestGetDayInfo_Pascha2026(t *testing.T) {
 	cal := newCalendar(t)
-	date := time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC)
+	date := models.NewDate(2026, 4, 12)
 	info := cal.GetDayInfo(date)
 
 	foundPascha := false
@@ -38,7 +37,7 @@ func TestGetDayInfo_Pascha2026(t *testing.T) {
 
 func TestGetDayInfo_Annunciation2026(t *testing.T) {
 	cal := newCalendar(t)
-	date := time.Date(2026, 3, 25, 0, 0, 0, 0, time.UTC)
+	date := models.NewDate(2026, 3, 25)
 	info := cal.GetDayInfo(date)
 
 	foundFeast := false
@@ -59,7 +58,7 @@ func TestGetDayInfo_Annunciation2026(t *testing.T) {
 
 func TestGetDayInfo_Christmas(t *testing.T) {
 	cal := newCalendar(t)
-	date := time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC)
+	date := models.NewDate(2026, 12, 25)
 	info := cal.GetDayInfo(date)
 
 	foundChristmas := false
@@ -79,7 +78,7 @@ func TestGetDayInfo_Christmas(t *testing.T) {
 
 func TestGetDayInfo_BeheadingAug29(t *testing.T) {
 	cal := newCalendar(t)
-	date := time.Date(2026, 8, 29, 0, 0, 0, 0, time.UTC)
+	date := models.NewDate(2026, 8, 29)
 	info := cal.GetDayInfo(date)
 
 	if info.FastingLevel != models.FastingStrict {
@@ -100,7 +99,7 @@ func TestGetDayInfo_BeheadingAug29(t *testing.T) {
 
 func TestGetDayInfo_QuoteDeterministic(t *testing.T) {
 	cal := newCalendar(t)
-	date := time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC)
+	date := models.NewDate(2026, 6, 15)
 	q1 := cal.GetDayInfo(date).Quote
 	q2 := cal.GetDayInfo(date).Quote
 	if q1.Text != q2.Text {
@@ -111,7 +110,7 @@ func TestGetDayInfo_QuoteDeterministic(t *testing.T) {
 func TestGetDayInfo_PalmSunday2026(t *testing.T) {
 	cal := newCalendar(t)
 	// Palm Sunday 2026 = April 5
-	date := time.Date(2026, 4, 5, 0, 0, 0, 0, time.UTC)
+	date := models.NewDate(2026, 4, 5)
 	info := cal.GetDayInfo(date)
 
 	foundPalm := false
@@ -128,3 +127,321 @@ func TestGetDayInfo_PalmSunday2026(t *testing.T) {
 		t.Errorf("Palm Sunday fasting: got %s, want fish", info.FastingLevel)
 	}
 }
+
+func TestGetDayInfo_JulianNativity(t *testing.T) {
+	d, err := data.Load()
+	if err != nil {
+		t.Fatalf("failed to load data: %v", err)
+	}
+	cal := New(d, models.StyleJulian)
+	// Dec 25 O.S. = Jan 7, 2027 N.S.
+	date := models.NewDate(2027, 1, 7)
+	info := cal.GetDayInfo(date)
+
+	foundChristmas := false
+	for _, f := range info.Feasts {
+		if f.Name == "Nativity of Christ (Christmas)" {
+			foundChristmas = true
+			break
+		}
+	}
+	if !foundChristmas {
+		t.Error("expected Christmas feast on Jan 7 N.S. in Julian style")
+	}
+	if got := info.JulianDate.String(); got != "2026-12-25" {
+		t.Errorf("Julian date: got %s, want 2026-12-25", got)
+	}
+	if info.FastingLevel != models.FastingNone {
+		t.Errorf("Julian Christmas fasting: got %s, want none", info.FastingLevel)
+	}
+	if len(info.Readings) == 0 || info.Readings[0].Gospel == nil || info.Readings[0].Gospel.Passage != "2:1-12" {
+		t.Errorf("Julian Christmas readings: got %+v, want Matthew 2:1-12", info.Readings)
+	}
+}
+
+func TestGetDayInfo_JulianDec25IsNativityFast(t *testing.T) {
+	d, err := data.Load()
+	if err != nil {
+		t.Fatalf("failed to load data: %v", err)
+	}
+	cal := New(d, models.StyleJulian)
+	// Dec 25, 2026 N.S. is Dec 12 O.S. — still in the Nativity Fast (Friday)
+	info := cal.GetDayInfo(models.NewDate(2026, 12, 25))
+
+	for _, f := range info.Feasts {
+		if f.Name == "Nativity of Christ (Christmas)" {
+			t.Error("did not expect Christmas on Dec 25 N.S. in Julian style")
+		}
+	}
+	if info.FastingLevel != models.FastingOilWine {
+		t.Errorf("Julian Dec 25 N.S. fasting: got %s, want oil_wine", info.FastingLevel)
+	}
+}
+
+func TestGetDayInfo_StGeorgeTransferredToBrightMonday(t *testing.T) {
+	cal := newCalendar(t)
+	// Pascha 2024 = May 5, so Apr 23 falls in Great Lent
+	info := cal.GetDayInfo(models.NewDate(2024, 4, 23))
+	for _, f := range info.Feasts {
+		if f.Name == "St. George the Great Martyr" {
+			t.Error("St. George should be transferred away from Apr 23, 2024")
+		}
+	}
+	for _, r := range info.Readings {
+		if r.Source == "Feast" {
+			t.Errorf("unexpected feast readings on Apr 23, 2024: %+v", r)
+		}
+	}
+
+	info = cal.GetDayInfo(models.NewDate(2024, 5, 6))
+	var george *models.Feast
+	for i, f := range info.Feasts {
+		if f.Name == "St. George the Great Martyr" {
+			george = &info.Feasts[i]
+		}
+	}
+	if george == nil {
+		t.Fatal("expected St. George on Bright Monday, May 6, 2024")
+	}
+	if george.TransferredFrom == nil || *george.TransferredFrom != models.NewDate(2024, 4, 23) {
+		t.Errorf("TransferredFrom: got %v, want 2024-04-23", george.TransferredFrom)
+	}
+	foundReading := false
+	for _, r := range info.Readings {
+		if r.Gospel != nil && r.Gospel.Passage == "15:17-16:2" {
+			foundReading = true
+		}
+	}
+	if !foundReading {
+		t.Error("expected St. George readings on Bright Monday")
+	}
+	if info.FastingLevel != models.FastingNone {
+		t.Errorf("Bright Monday fasting: got %s, want none", info.FastingLevel)
+	}
+}
+
+func TestGetDayInfo_StGeorgeAfterPascha(t *testing.T) {
+	cal := newCalendar(t)
+	// Pascha 2026 = Apr 12, so St. George stays on Apr 23
+	info := cal.GetDayInfo(models.NewDate(2026, 4, 23))
+	found := false
+	for _, f := range info.Feasts {
+		if f.Name == "St. George the Great Martyr" {
+			found = true
+			if f.TransferredFrom != nil {
+				t.Error("St. George should not be marked transferred in 2026")
+			}
+		}
+	}
+	if !found {
+		t.Error("expected St. George on Apr 23, 2026")
+	}
+}
+
+func TestGetDayInfo_StGeorgeSaintFollowsTransfer(t *testing.T) {
+	d := mustLoad(t)
+	tests := []struct {
+		style    models.CalendarStyle
+		from, to models.Date
+	}{
+		// Pascha 2027 = May 2, so Apr 23 falls in Holy Week
+		{models.StyleRevised, models.NewDate(2027, 4, 23), models.NewDate(2027, 5, 3)},
+		// Pascha 2040 = May 6 N.S. = Apr 23 O.S.
+		{models.StyleJulian, models.NewDate(2040, 5, 6), models.NewDate(2040, 5, 7)},
+	}
+
+	hasGeorge := func(info models.DayInfo) bool {
+		for _, s := range info.Saints {
+			if s.Name == "St. George the Great Martyr" {
+				return true
+			}
+		}
+		return false
+	}
+	for _, tt := range tests {
+		cal := New(d, tt.style)
+		if hasGeorge(cal.GetDayInfo(tt.from)) {
+			t.Errorf("%s %s: St. George should be transferred away with his feast", tt.style, tt.from)
+		}
+		if !hasGeorge(cal.GetDayInfo(tt.to)) {
+			t.Errorf("%s %s: expected St. George among the saints of Bright Monday", tt.style, tt.to)
+		}
+	}
+}
+
+func TestGetDayInfo_Kyriopascha(t *testing.T) {
+	d := mustLoad(t)
+	cal := New(d, models.StyleJulian)
+	// Pascha 1991 = Apr 7 N.S. = Mar 25 O.S.
+	info := cal.GetDayInfo(models.NewDate(1991, 4, 7))
+
+	names := map[string]bool{}
+	for _, f := range info.Feasts {
+		names[f.Name] = true
+	}
+	if !names["Pascha (Resurrection of Christ)"] || !names["Annunciation of the Theotokos"] {
+		t.Errorf("expected Pascha and the Annunciation together, got %v", names)
+	}
+	if len(info.Coincidences) != 1 || info.Coincidences[0] != "Kyriopascha — the Annunciation falls on Pascha" {
+		t.Errorf("Coincidences: got %v, want Kyriopascha", info.Coincidences)
+	}
+
+	// The Paschal readings are read first; the Annunciation's, if at all, after them
+	if len(info.Readings) == 0 {
+		t.Fatal("expected readings")
+	}
+	if g := info.Readings[0].Gospel; g == nil || g.Book != "John" || g.Passage != "1:1-17" {
+		t.Errorf("first Gospel = %+v, want John 1:1-17", g)
+	}
+}
+
+func TestGetDayInfo_FeastPeriods(t *testing.T) {
+	cal := newCalendar(t)
+	tests := []struct {
+		date models.Date
+		want string
+	}{
+		{models.NewDate(2026, 8, 5), "Forefeast of the Transfiguration"},
+		{models.NewDate(2026, 8, 8), "Afterfeast of the Transfiguration (day 3 of 8)"},
+		{models.NewDate(2026, 8, 13), "Apodosis of the Transfiguration"},
+		{models.NewDate(2026, 12, 22), "Forefeast of the Nativity (day 3 of 5)"},
+		{models.NewDate(2027, 1, 14), "Apodosis of Theophany"},
+		{models.NewDate(2026, 4, 14), "Afterfeast of Pascha (day 3 of 39)"},
+		{models.NewDate(2026, 5, 20), "Apodosis of Pascha"},
+		{models.NewDate(2026, 3, 26), "Apodosis of the Annunciation"},
+	}
+
+	for _, tt := range tests {
+		info := cal.GetDayInfo(tt.date)
+		var titles []string
+		for _, p := range info.FeastPeriods {
+			titles = append(titles, p.Title())
+		}
+		if len(titles) != 1 || titles[0] != tt.want {
+			t.Errorf("%s: got %v, want [%s]", tt.date, titles, tt.want)
+		}
+	}
+
+	// The feast day itself is not part of its own periods
+	if info := cal.GetDayInfo(models.NewDate(2026, 8, 6)); len(info.FeastPeriods) != 0 {
+		t.Errorf("Transfiguration: unexpected periods %+v", info.FeastPeriods)
+	}
+}
+
+func TestGetDayInfo_JulianAfterfeast(t *testing.T) {
+	d := mustLoad(t)
+	cal := New(d, models.StyleJulian)
+	// Aug 8 O.S. = Aug 21 N.S.
+	info := cal.GetDayInfo(models.NewDate(2026, 8, 21))
+	if len(info.FeastPeriods) != 1 || info.FeastPeriods[0].Title() != "Afterfeast of the Transfiguration (day 3 of 8)" {
+		t.Errorf("got %+v, want the 3rd day of the Transfiguration afterfeast", info.FeastPeriods)
+	}
+}
+
+func TestGetDayInfo_AnchoredSundays(t *testing.T) {
+	cal := newCalendar(t)
+	tests := []struct {
+		date models.Date
+		want string
+	}{
+		{models.NewDate(2026, 12, 13), "Sunday of the Holy Forefathers"},
+		{models.NewDate(2026, 12, 20), "Sunday before the Nativity"},
+		{models.NewDate(2026, 12, 27), "Sunday after the Nativity"},
+		{models.NewDate(2027, 1, 3), "Sunday before Theophany"},
+		{models.NewDate(2027, 1, 10), "Sunday after Theophany"},
+		{models.NewDate(2026, 9, 20), "Sunday after the Elevation of the Cross"},
+		{models.NewDate(2026, 10, 11), "Fathers of the Seventh Ecumenical Council"},
+		{models.NewDate(2026, 7, 19), "Fathers of the Fourth Ecumenical Council"},
+		// Nativity on a Sunday: "before" and "after" skip the feast itself
+		{models.NewDate(2022, 12, 11), "Sunday of the Holy Forefathers"},
+		{models.NewDate(2022, 12, 18), "Sunday before the Nativity"},
+		{models.NewDate(2023, 1, 1), "Sunday after the Nativity"},
+		// Oct 11, 2025 is a Saturday; the nearest Sunday is the next day
+		{models.NewDate(2025, 10, 12), "Fathers of the Seventh Ecumenical Council"},
+	}
+
+	for _, tt := range tests {
+		info := cal.GetDayInfo(tt.date)
+		found := false
+		for _, f := range info.Feasts {
+			if f.Name == tt.want {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s: expected %q, got %+v", tt.date, tt.want, info.Feasts)
+		}
+	}
+
+	// Jul 16, 2026 is a Thursday, so the Fourth Council is on Jul 19, not Jul 12
+	for _, f := range cal.GetDayInfo(models.NewDate(2026, 7, 12)).Feasts {
+		if f.Anchor != nil {
+			t.Errorf("Jul 12, 2026: unexpected %q", f.Name)
+		}
+	}
+}
+
+func TestGetDayInfo_AnchoredSundaysCoincide(t *testing.T) {
+	cal := newCalendar(t)
+	// Christmas on a Monday: the one Sunday between the Nativity and Theophany
+	// is kept as the Sunday after the Nativity, with its readings.
+	for _, date := range []models.Date{models.NewDate(2023, 12, 31), models.NewDate(2028, 12, 31)} {
+		info := cal.GetDayInfo(date)
+		var names []string
+		for _, f := range info.Feasts {
+			if f.Anchor != nil {
+				names = append(names, f.Name)
+			}
+		}
+		if len(names) != 1 || names[0] != "Sunday after the Nativity" {
+			t.Errorf("%s: got %v, want only the Sunday after the Nativity", date, names)
+		}
+		if len(info.Readings) == 0 || info.Readings[0].Gospel == nil || info.Readings[0].Gospel.Passage != "2:13-23" {
+			t.Errorf("%s: expected Matthew 2:13-23, got %+v", date, info.Readings)
+		}
+	}
+}
+
+func TestGetDayInfo_JulianAnchoredSunday(t *testing.T) {
+	d := mustLoad(t)
+	cal := New(d, models.StyleJulian)
+	// Dec 25 O.S. = Thu Jan 7, 2027 N.S.; the Sunday after is Jan 10
+	info := cal.GetDayInfo(models.NewDate(2027, 1, 10))
+	if len(info.Feasts) == 0 || info.Feasts[0].Name != "Sunday after the Nativity" {
+		t.Errorf("got %+v, want Sunday after the Nativity", info.Feasts)
+	}
+}
+
+func TestGetDayInfo_SaturdaysOfSouls(t *testing.T) {
+	cal := newCalendar(t)
+	// Pascha 2026 is April 12
+	tests := []struct {
+		date   models.Date
+		want   string
+		gospel string
+	}{
+		{models.NewDate(2026, 2, 14), "Saturday of Souls (Meatfare Saturday)", "21:8-9,25-27,33-36"},
+		{models.NewDate(2026, 3, 7), "Saturday of Souls (2nd Saturday of Great Lent)", "1:35-44"},
+		{models.NewDate(2026, 3, 14), "Saturday of Souls (3rd Saturday of Great Lent)", "2:14-17"},
+		{models.NewDate(2026, 3, 21), "Saturday of Souls (4th Saturday of Great Lent)", "7:31-37"},
+		{models.NewDate(2026, 5, 30), "Saturday of Souls (before Pentecost)", "5:24-30"},
+	}
+
+	for _, tt := range tests {
+		info := cal.GetDayInfo(tt.date)
+		if tt.date.Weekday() != 6 {
+			t.Errorf("%s: not a Saturday", tt.date)
+		}
+		if len(info.Memorials) != 1 || info.Memorials[0].Name != tt.want {
+			t.Errorf("%s: got %+v, want %q", tt.date, info.Memorials, tt.want)
+		}
+		if len(info.Readings) == 0 || info.Readings[0].Gospel == nil || info.Readings[0].Gospel.Passage != tt.gospel {
+			t.Errorf("%s: got readings %+v, want gospel %s", tt.date, info.Readings, tt.gospel)
+		}
+	}
+
+	// The 1st Saturday of Lent is St. Theodore's, not a Saturday of Souls
+	if info := cal.GetDayInfo(models.NewDate(2026, 2, 28)); len(info.Memorials) != 0 {
+		t.Errorf("Feb 28: unexpected %+v", info.Memorials)
+	}
+}
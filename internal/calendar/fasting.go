@@ -1,69 +1,113 @@
 package calendar
 
 import (
+	"fmt"
 	"greekOrtho/internal/models"
-	"time"
 )
 
 // ResolveFasting determines the fasting level and reason for a given date.
 // It evaluates all rules, picks the highest-priority matching rule, applies
-// weekday overrides, and then applies feast-day fasting overrides (only if more lenient).
-func ResolveFasting(date time.Time, pascha time.Time, rules []models.FastingRule, feasts []models.Feast) (models.FastingLevel, string) {
-	daysFromPascha := int(date.Sub(pascha).Hours() / 24)
+// its weekday overrides and its relaxations for the day's feasts, and then
+// applies feast-day fasting overrides: strict ones when they are stricter,
+// others when they are more lenient.
+// Fixed-date periods are matched on the calendar style's date.
+func ResolveFasting(date models.Date, pascha models.Date, style models.CalendarStyle, rules []models.FastingRule, feasts []models.Feast) (models.FastingLevel, string) {
+	e := ResolveFastingExplained(date, pascha, style, rules, feasts)
+	return e.Level, e.Reason
+}
 
-	var bestRule *models.FastingRule
-	var bestPriority int = -1
+// ResolveFastingExplained resolves the fasting level like ResolveFasting and
+// records each step: every matching rule with the one chosen, the weekday
+// override applied, and each feast relaxation and override accepted or rejected.
+func ResolveFastingExplained(date models.Date, pascha models.Date, style models.CalendarStyle, rules []models.FastingRule, feasts []models.Feast) models.FastingExplanation {
+	daysFromPascha := date.Sub(pascha)
+	fixed := fixedDate(date, style)
 
+	var e models.FastingExplanation
+	best := -1
 	for i := range rules {
 		r := &rules[i]
-		if !ruleMatches(date, daysFromPascha, pascha, r) {
+		if !ruleMatches(date, fixed, daysFromPascha, pascha, style, &r.DateSpan) {
 			continue
 		}
-		if r.Priority > bestPriority {
-			bestPriority = r.Priority
-			bestRule = r
+		e.Candidates = append(e.Candidates, models.FastingCandidate{Rule: *r})
+		if best < 0 || r.Priority > e.Candidates[best].Rule.Priority {
+			best = len(e.Candidates) - 1
 		}
 	}
 
-	level := models.FastingNone
-	reason := "No fasting today"
+	e.Level = models.FastingNone
+	e.Reason = "No fasting today"
 
-	if bestRule != nil {
-		level = bestRule.Level
+	if best >= 0 {
+		e.Candidates[best].Chosen = true
+		bestRule := e.Candidates[best].Rule
+		e.Level = bestRule.Level
 
 		// Apply weekday overrides within the period
 		for _, ov := range bestRule.WeekdayOverrides {
 			if date.Weekday() == ov.Weekday {
-				level = ov.Level
+				e.Level = ov.Level
+				e.WeekdayOverride = &ov
 				break
 			}
 		}
-		reason = bestRule.Description
+		e.Reason = bestRule.Description
+
+		// Apply the rule's relaxations for the day's feasts — only if more lenient
+		for _, rx := range bestRule.FeastRelaxations {
+			for _, f := range feasts {
+				if !rx.Matches(f, date.Weekday()) {
+					continue
+				}
+				d := models.FeastOverrideDecision{Feast: f.Name, Level: rx.Level, Note: rx.Description}
+				if e.Level.StricterThan(rx.Level) {
+					d.Accepted = true
+					e.Level = rx.Level
+					e.Reason = f.Name + " — " + rx.Description
+				} else {
+					d.Note += fmt.Sprintf(" (not more lenient than %s)", e.Level)
+				}
+				e.Relaxations = append(e.Relaxations, d)
+			}
+		}
 	}
 
-	// Apply feast-day fasting overrides — only if more lenient
+	// Apply feast-day fasting overrides. An override at least as strict as
+	// the strict fast (e.g., Beheading, Elevation of the Cross) makes the day
+	// stricter; any other only relaxes it (e.g., Annunciation = fish during Lent).
 	for _, f := range feasts {
-		if f.FastingOverride != nil {
-			override := *f.FastingOverride
-			if override == models.FastingStrict {
-				// Strict overrides (e.g., Beheading, Elevation of Cross) always apply
-				if models.FastingLevelSeverity(override) < models.FastingLevelSeverity(level) {
-					level = override
-					reason = f.Name + " — strict fast day"
-				}
-			} else if models.FastingLevelSeverity(override) > models.FastingLevelSeverity(level) {
-				// More lenient overrides (e.g., Annunciation = fish during Lent)
-				level = override
-				reason = f.Name + " — fasting relaxed for the feast"
+		if f.FastingOverride == nil {
+			continue
+		}
+		override := *f.FastingOverride
+		d := models.FeastOverrideDecision{Feast: f.Name, Level: override}
+		if override == models.FastingStrict || override.StricterThan(models.FastingStrict) {
+			if override.StricterThan(e.Level) {
+				d.Accepted = true
+				d.Note = fmt.Sprintf("strict fast day, stricter than %s", e.Level)
+				e.Level = override
+				e.Reason = f.Name + " — strict fast day"
+			} else {
+				d.Note = fmt.Sprintf("not stricter than %s", e.Level)
 			}
+		} else if e.Level.StricterThan(override) {
+			d.Accepted = true
+			d.Note = fmt.Sprintf("more lenient than %s", e.Level)
+			e.Level = override
+			e.Reason = f.Name + " — fasting relaxed for the feast"
+		} else {
+			d.Note = fmt.Sprintf("not more lenient than %s", e.Level)
 		}
+		e.FeastOverrides = append(e.FeastOverrides, d)
 	}
 
-	return level, reason
+	return e
 }
 
-// ruleMatches checks if a fasting rule applies to the given date.
-func ruleMatches(date time.Time, daysFromPascha int, pascha time.Time, r *models.FastingRule) bool {
+// ruleMatches checks if a rule's span covers the given date. Fixed month/day
+// bounds are compared against fixed, the date in the calendar style.
+func ruleMatches(date models.Date, fixed models.Date, daysFromPascha int, pascha models.Date, style models.CalendarStyle, r *models.DateSpan) bool {
 	// Weekday-only rules (Wed/Fri)
 	if r.WeekdayOnly != nil {
 		return int(date.Weekday()) == *r.WeekdayOnly
@@ -79,13 +123,13 @@ func ruleMatches(date time.Time, daysFromPascha int, pascha time.Time, r *models
 	if r.FixedStartMonth != nil && r.FixedStartDay != nil &&
 		r.FixedEndMonth != nil && r.FixedEndDay != nil &&
 		r.PaschaOffsetStart == nil {
-		return inFixedRange(date, *r.FixedStartMonth, *r.FixedStartDay, *r.FixedEndMonth, *r.FixedEndDay)
+		return inFixedRange(fixed, *r.FixedStartMonth, *r.FixedStartDay, *r.FixedEndMonth, *r.FixedEndDay)
 	}
 
 	// Hybrid: Pascha-offset start, fixed date end (Apostles' Fast)
 	if r.PaschaOffsetStart != nil && r.FixedEndMonth != nil && r.FixedEndDay != nil {
-		start := pascha.AddDate(0, 0, *r.PaschaOffsetStart)
-		end := time.Date(date.Year(), time.Month(*r.FixedEndMonth), *r.FixedEndDay, 0, 0, 0, 0, time.UTC)
+		start := pascha.AddDays(*r.PaschaOffsetStart)
+		end := civilDate(fixed.Year, *r.FixedEndMonth, *r.FixedEndDay, style)
 		// The fast only exists if start is before end
 		if !start.Before(end) {
 			return false
@@ -97,9 +141,9 @@ func ruleMatches(date time.Time, daysFromPascha int, pascha time.Time, r *models
 }
 
 // inFixedRange checks if date falls within a fixed month/day range, handling year boundaries.
-func inFixedRange(date time.Time, startMonth, startDay, endMonth, endDay int) bool {
-	m := int(date.Month())
-	d := date.Day()
+func inFixedRange(date models.Date, startMonth, startDay, endMonth, endDay int) bool {
+	m := int(date.Month)
+	d := date.Day
 
 	startVal := startMonth*100 + startDay
 	endVal := endMonth*100 + endDay
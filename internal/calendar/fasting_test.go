@@ -5,7 +5,6 @@ import (
 	"greekOrtho/internal/models"
 	"greekOrtho/internal/pascha"
 	"testing"
-	"time"
 )
 
 func mustLoad(t *testing.T) *data.CalendarData {
@@ -21,8 +20,8 @@ func TestFasting_GreatLentWeekday(t *testing.T) {
 	d := mustLoad(t)
 	// 2026 Pascha = April 12. Clean Monday = Feb 23. A Monday in Lent: March 2, 2026
 	p := pascha.Compute(2026)
-	date := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
-	level, _ := ResolveFasting(date, p, d.FastingRules, nil)
+	date := models.NewDate(2026, 3, 2)
+	level, _ := ResolveFasting(date, p, models.StyleRevised, d.FastingRules, nil)
 	if level != models.FastingStrict {
 		t.Errorf("Great Lent weekday: got %s, want strict", level)
 	}
@@ -32,8 +31,8 @@ func TestFasting_GreatLentSaturday(t *testing.T) {
 	d := mustLoad(t)
 	p := pascha.Compute(2026)
 	// Saturday March 7, 2026 — should be oil_wine
-	date := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
-	level, _ := ResolveFasting(date, p, d.FastingRules, nil)
+	date := models.NewDate(2026, 3, 7)
+	level, _ := ResolveFasting(date, p, models.StyleRevised, d.FastingRules, nil)
 	if level != models.FastingOilWine {
 		t.Errorf("Great Lent Saturday: got %s, want oil_wine", level)
 	}
@@ -43,8 +42,8 @@ func TestFasting_HolyWeek(t *testing.T) {
 	d := mustLoad(t)
 	p := pascha.Compute(2026)
 	// Holy Wednesday = April 8, 2026
-	date := time.Date(2026, 4, 8, 0, 0, 0, 0, time.UTC)
-	level, _ := ResolveFasting(date, p, d.FastingRules, nil)
+	date := models.NewDate(2026, 4, 8)
+	level, _ := ResolveFasting(date, p, models.StyleRevised, d.FastingRules, nil)
 	if level != models.FastingStrict {
 		t.Errorf("Holy Week: got %s, want strict", level)
 	}
@@ -54,8 +53,8 @@ func TestFasting_BrightWeek(t *testing.T) {
 	d := mustLoad(t)
 	p := pascha.Compute(2026)
 	// Bright Wednesday = April 15, 2026
-	date := time.Date(2026, 4, 15, 0, 0, 0, 0, time.UTC)
-	level, _ := ResolveFasting(date, p, d.FastingRules, nil)
+	date := models.NewDate(2026, 4, 15)
+	level, _ := ResolveFasting(date, p, models.StyleRevised, d.FastingRules, nil)
 	if level != models.FastingNone {
 		t.Errorf("Bright Week: got %s, want none", level)
 	}
@@ -65,8 +64,8 @@ func TestFasting_WednesdayRegular(t *testing.T) {
 	d := mustLoad(t)
 	p := pascha.Compute(2026)
 	// A regular Wednesday outside any fast period: July 1, 2026
-	date := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
-	level, _ := ResolveFasting(date, p, d.FastingRules, nil)
+	date := models.NewDate(2026, 7, 1)
+	level, _ := ResolveFasting(date, p, models.StyleRevised, d.FastingRules, nil)
 	if level != models.FastingOilWine {
 		t.Errorf("Regular Wednesday: got %s, want oil_wine", level)
 	}
@@ -76,8 +75,8 @@ func TestFasting_RegularTuesday(t *testing.T) {
 	d := mustLoad(t)
 	p := pascha.Compute(2026)
 	// A regular Tuesday outside any fast period: June 30, 2026
-	date := time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)
-	level, _ := ResolveFasting(date, p, d.FastingRules, nil)
+	date := models.NewDate(2026, 6, 30)
+	level, _ := ResolveFasting(date, p, models.StyleRevised, d.FastingRules, nil)
 	if level != models.FastingNone {
 		t.Errorf("Regular Tuesday: got %s, want none", level)
 	}
@@ -86,13 +85,13 @@ func TestFasting_RegularTuesday(t *testing.T) {
 func TestFasting_AnnunciationDuringLent(t *testing.T) {
 	d := mustLoad(t)
 	p := pascha.Compute(2026)
-	date := time.Date(2026, 3, 25, 0, 0, 0, 0, time.UTC)
+	date := models.NewDate(2026, 3, 25)
 	// Pass Annunciation feast with fish override
 	fishLevel := models.FastingFish
 	feasts := []models.Feast{
 		{Name: "Annunciation of the Theotokos", FastingOverride: &fishLevel},
 	}
-	level, _ := ResolveFasting(date, p, d.FastingRules, feasts)
+	level, _ := ResolveFasting(date, p, models.StyleRevised, d.FastingRules, feasts)
 	if level != models.FastingFish {
 		t.Errorf("Annunciation during Lent: got %s, want fish", level)
 	}
@@ -101,12 +100,12 @@ func TestFasting_AnnunciationDuringLent(t *testing.T) {
 func TestFasting_BeheadingStrictOverride(t *testing.T) {
 	d := mustLoad(t)
 	p := pascha.Compute(2026)
-	date := time.Date(2026, 8, 29, 0, 0, 0, 0, time.UTC) // Saturday
+	date := models.NewDate(2026, 8, 29) // Saturday
 	strictLevel := models.FastingStrict
 	feasts := []models.Feast{
 		{Name: "Beheading of John the Baptist", FastingOverride: &strictLevel},
 	}
-	level, _ := ResolveFasting(date, p, d.FastingRules, feasts)
+	level, _ := ResolveFasting(date, p, models.StyleRevised, d.FastingRules, feasts)
 	if level != models.FastingStrict {
 		t.Errorf("Beheading of John Baptist: got %s, want strict", level)
 	}
@@ -116,8 +115,8 @@ func TestFasting_DormitionFast(t *testing.T) {
 	d := mustLoad(t)
 	p := pascha.Compute(2026)
 	// Aug 5, 2026 is a Wednesday — weekday in Dormition fast
-	date := time.Date(2026, 8, 5, 0, 0, 0, 0, time.UTC)
-	level, _ := ResolveFasting(date, p, d.FastingRules, nil)
+	date := models.NewDate(2026, 8, 5)
+	level, _ := ResolveFasting(date, p, models.StyleRevised, d.FastingRules, nil)
 	if level != models.FastingStrict {
 		t.Errorf("Dormition Fast weekday: got %s, want strict", level)
 	}
@@ -127,8 +126,8 @@ func TestFasting_NativityFast(t *testing.T) {
 	d := mustLoad(t)
 	p := pascha.Compute(2026)
 	// Dec 1, 2026 is a Tuesday — should be fish
-	date := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
-	level, _ := ResolveFasting(date, p, d.FastingRules, nil)
+	date := models.NewDate(2026, 12, 1)
+	level, _ := ResolveFasting(date, p, models.StyleRevised, d.FastingRules, nil)
 	if level != models.FastingFish {
 		t.Errorf("Nativity Fast Tuesday: got %s, want fish", level)
 	}
@@ -138,8 +137,8 @@ func TestFasting_CheesfareWeek(t *testing.T) {
 	d := mustLoad(t)
 	p := pascha.Compute(2026)
 	// Cheesefare week 2026: Feb 16-22 (Pascha offset -55 to -49)
-	date := time.Date(2026, 2, 18, 0, 0, 0, 0, time.UTC) // Wednesday
-	level, _ := ResolveFasting(date, p, d.FastingRules, nil)
+	date := models.NewDate(2026, 2, 18) // Wednesday
+	level, _ := ResolveFasting(date, p, models.StyleRevised, d.FastingRules, nil)
 	if level != models.FastingDairyFish {
 		t.Errorf("Cheesefare Week: got %s, want dairy_fish", level)
 	}
@@ -148,9 +147,157 @@ func TestFasting_CheesfareWeek(t *testing.T) {
 func TestFasting_Christmas(t *testing.T) {
 	d := mustLoad(t)
 	p := pascha.Compute(2026)
-	date := time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC)
-	level, _ := ResolveFasting(date, p, d.FastingRules, nil)
+	date := models.NewDate(2026, 12, 25)
+	level, _ := ResolveFasting(date, p, models.StyleRevised, d.FastingRules, nil)
 	if level != models.FastingNone {
 		t.Errorf("Christmas: got %s, want none", level)
 	}
 }
+
+func TestFastingExplained_Lent(t *testing.T) {
+	d := mustLoad(t)
+	p := pascha.Compute(2026)
+	fish := models.FastingFish
+	strict := models.FastingStrict
+	// Annunciation, Wed Mar 25, 2026: Great Lent outranks the Wednesday fast;
+	// a strict override cannot make the strict day stricter, and the feast
+	// relaxes it.
+	feasts := []models.Feast{
+		{Name: "Strict Feast", FastingOverride: &strict},
+		{Name: "Annunciation of the Theotokos", FastingOverride: &fish},
+	}
+	e := ResolveFastingExplained(models.NewDate(2026, 3, 25), p, models.StyleRevised, d.FastingRules, feasts)
+
+	var chosen []string
+	for _, c := range e.Candidates {
+		if c.Chosen {
+			chosen = append(chosen, c.Rule.Name)
+		}
+	}
+	if len(e.Candidates) < 2 || len(chosen) != 1 || chosen[0] != "Great Lent" {
+		t.Errorf("candidates = %+v, want Great Lent chosen among several", e.Candidates)
+	}
+	if e.WeekdayOverride != nil {
+		t.Errorf("weekday override = %+v, want none on a Wednesday", e.WeekdayOverride)
+	}
+	if len(e.FeastOverrides) != 2 || e.FeastOverrides[0].Accepted || !e.FeastOverrides[1].Accepted {
+		t.Errorf("feast overrides = %+v, want the first rejected and the second accepted", e.FeastOverrides)
+	}
+	if e.Level != models.FastingFish {
+		t.Errorf("level = %s, want fish", e.Level)
+	}
+}
+
+func TestFastingExplained_WeekdayOverride(t *testing.T) {
+	d := mustLoad(t)
+	p := pascha.Compute(2026)
+	e := ResolveFastingExplained(models.NewDate(2026, 3, 7), p, models.StyleRevised, d.FastingRules, nil)
+	if e.WeekdayOverride == nil || e.WeekdayOverride.Level != models.FastingOilWine {
+		t.Errorf("weekday override = %+v, want oil_wine on a Lenten Saturday", e.WeekdayOverride)
+	}
+}
+
+func TestFastingExplained_MatchesResolveFasting(t *testing.T) {
+	d := mustLoad(t)
+	p := pascha.Compute(2026)
+	for date := models.NewDate(2026, 1, 1); date.Year == 2026; date = date.AddDays(1) {
+		level, reason := ResolveFasting(date, p, models.StyleRevised, d.FastingRules, nil)
+		e := ResolveFastingExplained(date, p, models.StyleRevised, d.FastingRules, nil)
+		if e.Level != level || e.Reason != reason {
+			t.Fatalf("%s: explained %s (%q), resolved %s (%q)", date, e.Level, e.Reason, level, reason)
+		}
+	}
+}
+
+func TestFasting_HolyWeekDays(t *testing.T) {
+	d := mustLoad(t)
+	p := pascha.Compute(2026)
+	tests := []struct {
+		date models.Date
+		want models.FastingLevel
+	}{
+		{models.NewDate(2026, 2, 23), models.FastingTotal},     // Clean Monday
+		{models.NewDate(2026, 2, 25), models.FastingXerophagy}, // Wednesday of the first week
+		{models.NewDate(2026, 2, 28), models.FastingOilWine},   // first Saturday of Lent
+		{models.NewDate(2026, 4, 9), models.FastingOilWine},    // Holy Thursday
+		{models.NewDate(2026, 4, 10), models.FastingTotal},     // Holy Friday
+		{models.NewDate(2026, 4, 11), models.FastingWine},      // Holy Saturday
+	}
+	for _, tt := range tests {
+		level, _ := ResolveFasting(tt.date, p, models.StyleRevised, d.FastingRules, nil)
+		if level != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.date, level, tt.want)
+		}
+	}
+}
+
+func TestFasting_StrictOverrideOnTotalFast(t *testing.T) {
+	d := mustLoad(t)
+	p := pascha.Compute(2026)
+	strict := models.FastingStrict
+	feasts := []models.Feast{{Name: "Strict Feast", FastingOverride: &strict}}
+	// A strict override does not relax a day of total abstinence.
+	level, _ := ResolveFasting(models.NewDate(2026, 4, 10), p, models.StyleRevised, d.FastingRules, feasts)
+	if level != models.FastingTotal {
+		t.Errorf("Holy Friday with a strict feast: got %s, want total", level)
+	}
+}
+
+func TestFasting_FeastRelaxations(t *testing.T) {
+	d := mustLoad(t)
+	strict := models.FastingStrict
+	major := models.Feast{Name: "Major Feast", Rank: models.RankMajor}
+	minor := models.Feast{Name: "Minor Feast", Rank: models.RankMinor}
+	lord := models.Feast{Name: "Feast of the Lord", Rank: models.RankMinor, Kind: models.KindLord}
+	elevation := models.Feast{Name: "Elevation of the Holy Cross", Rank: models.RankGreat, FastingOverride: &strict}
+
+	tests := []struct {
+		name   string
+		date   models.Date
+		feasts []models.Feast
+		want   models.FastingLevel
+	}{
+		// Outside the fasts, a major feast on Wednesday or Friday allows fish.
+		{"major feast on a Wednesday", models.NewDate(2026, 7, 1), []models.Feast{major}, models.FastingFish},
+		{"minor feast on a Wednesday", models.NewDate(2026, 7, 1), []models.Feast{minor}, models.FastingOilWine},
+		{"major feast on a Monday", models.NewDate(2026, 7, 6), []models.Feast{major}, models.FastingNone},
+		// A strict override still applies after the relaxation.
+		{"Elevation on a Wednesday", models.NewDate(2022, 9, 14), []models.Feast{elevation}, models.FastingStrict},
+		// Oil and wine for a vigil feast on a Lenten weekday.
+		{"major feast in Lent", models.NewDate(2026, 3, 10), []models.Feast{major}, models.FastingOilWine},
+		{"minor feast in Lent", models.NewDate(2026, 3, 10), []models.Feast{minor}, models.FastingStrict},
+		// Fish for a feast of the Lord, or a vigil feast on Wednesday or Friday, in the Nativity Fast.
+		{"feast of the Lord in the Nativity Fast", models.NewDate(2026, 12, 2), []models.Feast{lord}, models.FastingFish},
+		{"major feast in the Nativity Fast", models.NewDate(2026, 12, 4), []models.Feast{major}, models.FastingFish},
+		{"minor feast in the Nativity Fast", models.NewDate(2026, 12, 2), []models.Feast{minor}, models.FastingOilWine},
+		// Holy Week has no relaxations.
+		{"major feast in Holy Week", models.NewDate(2026, 4, 7), []models.Feast{major}, models.FastingStrict},
+	}
+	for _, tt := range tests {
+		p := pascha.Compute(tt.date.Year)
+		level, _ := ResolveFasting(tt.date, p, models.StyleRevised, d.FastingRules, tt.feasts)
+		if level != tt.want {
+			t.Errorf("%s (%s): got %s, want %s", tt.name, tt.date, level, tt.want)
+		}
+	}
+}
+
+func TestFasting_ThreeHierarchsOnFriday(t *testing.T) {
+	d := mustLoad(t)
+	cal := New(d, models.StyleRevised)
+	// Jan 30, 2026 is a Friday
+	info := cal.GetDayInfo(models.NewDate(2026, 1, 30))
+	if info.FastingLevel != models.FastingFish {
+		t.Errorf("Three Hierarchs on a Friday: got %s (%s), want fish", info.FastingLevel, info.FastingReason)
+	}
+}
+
+func TestFastingExplained_Relaxation(t *testing.T) {
+	d := mustLoad(t)
+	p := pascha.Compute(2026)
+	feasts := []models.Feast{{Name: "Major Feast", Rank: models.RankMajor}}
+	e := ResolveFastingExplained(models.NewDate(2026, 7, 1), p, models.StyleRevised, d.FastingRules, feasts)
+	if len(e.Relaxations) != 1 || !e.Relaxations[0].Accepted || e.Relaxations[0].Feast != "Major Feast" {
+		t.Errorf("relaxations = %+v, want one accepted for Major Feast", e.Relaxations)
+	}
+}
@@ -0,0 +1,119 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"slices"
+)
+
+// FastingPeriods returns the named fasting periods of a year in date order:
+// the spans of the fasting rules that fast (Great Lent, the Dormition Fast),
+// leaving out weekly fasts and the rules within a longer period (Clean
+// Monday within Great Lent). A period may end in the next year.
+func (c *Calendar) FastingPeriods(year int) []models.FastingPeriod {
+	return c.year(year).fastingPeriods
+}
+
+// FastingStatus places date among the fasting periods: the period it is in,
+// the next one to begin, and the next day without fasting.
+func (c *Calendar) FastingStatus(date models.Date) models.FastingStatus {
+	s := c.periodStatus(date)
+	for d := date.AddDays(1); d.Sub(date) <= 366; d = d.AddDays(1) {
+		if level, _ := c.fastingOn(d); level == models.FastingNone {
+			s.NextFastFree = d
+			break
+		}
+	}
+	return s
+}
+
+// periodStatus finds the period date is in and the next one from the cached
+// fasting periods, leaving NextFastFree unset: finding it resolves the fast of
+// each day ahead, too much to do for every day of a Range.
+func (c *Calendar) periodStatus(date models.Date) models.FastingStatus {
+	var s models.FastingStatus
+	after := date
+	for _, y := range []int{date.Year - 1, date.Year, date.Year + 1} {
+		for _, p := range c.year(y).fastingPeriods {
+			if s.Current == nil && p.Contains(date) {
+				s.Current = &p
+				after = p.End
+			}
+		}
+	}
+	for _, y := range []int{date.Year, date.Year + 1} {
+		for _, p := range c.year(y).fastingPeriods {
+			if s.Next == nil && p.Start.After(after) {
+				s.Next = &p
+			}
+		}
+	}
+	return s
+}
+
+// fastingOn resolves the canonical fasting level and reason of date.
+func (c *Calendar) fastingOn(date models.Date) (models.FastingLevel, string) {
+	ly := c.year(date.Year)
+	return ResolveFasting(date, ly.pascha, c.style, c.data.FastingRules, c.findFeasts(date, fixedDate(date, c.style), ly))
+}
+
+// resolveFastingPeriods returns the named fasting periods of a year, as
+// FastingPeriods describes them.
+func resolveFastingPeriods(year int, pascha models.Date, style models.CalendarStyle, rules []models.FastingRule) []models.FastingPeriod {
+	var all []models.FastingPeriod
+	for _, r := range rules {
+		if r.Level == models.FastingNone {
+			continue
+		}
+		start, end, ok := spanDates(year, pascha, style, r.DateSpan)
+		if !ok {
+			continue
+		}
+		all = append(all, models.FastingPeriod{Name: r.Name, Level: r.Level, Description: r.Description, Start: start, End: end})
+	}
+
+	// Longer periods first among those starting together, so that each
+	// period within another comes after it and is skipped.
+	slices.SortStableFunc(all, func(a, b models.FastingPeriod) int {
+		if a.Start != b.Start {
+			return a.Start.Sub(b.Start)
+		}
+		return b.End.Sub(a.End)
+	})
+	var periods []models.FastingPeriod
+	for _, p := range all {
+		if n := len(periods); n > 0 && !p.End.After(periods[n-1].End) {
+			continue
+		}
+		periods = append(periods, p)
+	}
+	return periods
+}
+
+// spanDates returns the first and last day a span covers in a year, matching
+// ruleMatches. Weekday-only spans have none.
+func spanDates(year int, pascha models.Date, style models.CalendarStyle, s models.DateSpan) (models.Date, models.Date, bool) {
+	switch {
+	case s.WeekdayOnly != nil:
+		return models.Date{}, models.Date{}, false
+
+	case s.PaschaOffsetStart != nil && s.PaschaOffsetEnd != nil &&
+		s.FixedStartMonth == nil && s.FixedEndMonth == nil:
+		return pascha.AddDays(*s.PaschaOffsetStart), pascha.AddDays(*s.PaschaOffsetEnd), true
+
+	case s.FixedStartMonth != nil && s.FixedStartDay != nil &&
+		s.FixedEndMonth != nil && s.FixedEndDay != nil &&
+		s.PaschaOffsetStart == nil:
+		endYear := year
+		if dayKey(*s.FixedEndMonth, *s.FixedEndDay) < dayKey(*s.FixedStartMonth, *s.FixedStartDay) {
+			endYear++ // Wraps around the new year
+		}
+		return civilDate(year, *s.FixedStartMonth, *s.FixedStartDay, style),
+			civilDate(endYear, *s.FixedEndMonth, *s.FixedEndDay, style), true
+
+	case s.PaschaOffsetStart != nil && s.FixedEndMonth != nil && s.FixedEndDay != nil:
+		start := pascha.AddDays(*s.PaschaOffsetStart)
+		end := civilDate(year, *s.FixedEndMonth, *s.FixedEndDay, style)
+		return start, end, start.Before(end)
+	}
+	return models.Date{}, models.Date{}, false
+}
@@ -0,0 +1,103 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"testing"
+)
+
+func TestFastingPeriods(t *testing.T) {
+	tests := []struct {
+		style models.CalendarStyle
+		want  []models.FastingPeriod
+	}{
+		{models.StyleRevised, []models.FastingPeriod{
+			{Name: "Cheesefare Week", Start: models.NewDate(2026, 2, 16), End: models.NewDate(2026, 2, 22)},
+			{Name: "Great Lent", Start: models.NewDate(2026, 2, 23), End: models.NewDate(2026, 4, 5)},
+			{Name: "Holy Week", Start: models.NewDate(2026, 4, 6), End: models.NewDate(2026, 4, 11)},
+			{Name: "Apostles' Fast", Start: models.NewDate(2026, 6, 8), End: models.NewDate(2026, 6, 28)},
+			{Name: "Dormition Fast", Start: models.NewDate(2026, 8, 1), End: models.NewDate(2026, 8, 14)},
+			{Name: "Nativity Fast", Start: models.NewDate(2026, 11, 15), End: models.NewDate(2026, 12, 24)},
+		}},
+		{models.StyleJulian, []models.FastingPeriod{
+			{Name: "Cheesefare Week", Start: models.NewDate(2026, 2, 16), End: models.NewDate(2026, 2, 22)},
+			{Name: "Great Lent", Start: models.NewDate(2026, 2, 23), End: models.NewDate(2026, 4, 5)},
+			{Name: "Holy Week", Start: models.NewDate(2026, 4, 6), End: models.NewDate(2026, 4, 11)},
+			{Name: "Apostles' Fast", Start: models.NewDate(2026, 6, 8), End: models.NewDate(2026, 7, 11)},
+			{Name: "Dormition Fast", Start: models.NewDate(2026, 8, 14), End: models.NewDate(2026, 8, 27)},
+			{Name: "Nativity Fast", Start: models.NewDate(2026, 11, 28), End: models.NewDate(2027, 1, 6)},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.style), func(t *testing.T) {
+			got := New(mustLoad(t), tt.style).FastingPeriods(2026)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d periods, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i, w := range tt.want {
+				g := got[i]
+				if g.Name != w.Name || g.Start != w.Start || g.End != w.End {
+					t.Errorf("period %d = %s %s–%s, want %s %s–%s", i, g.Name, g.Start, g.End, w.Name, w.Start, w.End)
+				}
+			}
+		})
+	}
+}
+
+func TestFastingPeriods_NoApostlesFast(t *testing.T) {
+	// With Pascha on May 5, 2024, the Monday after All Saints is July 1,
+	// past June 28, so the New Calendar has no Apostles' Fast.
+	for _, p := range New(mustLoad(t), models.StyleRevised).FastingPeriods(2024) {
+		if p.Name == "Apostles' Fast" {
+			t.Errorf("unexpected Apostles' Fast %s–%s", p.Start, p.End)
+		}
+	}
+}
+
+func TestFastingStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		style    models.CalendarStyle
+		date     models.Date
+		current  string
+		next     string
+		fastFree models.Date
+	}{
+		{"in Lent", models.StyleRevised, models.NewDate(2026, 3, 2), "Great Lent", "Holy Week", models.NewDate(2026, 4, 12)},
+		{"Clean Monday", models.StyleRevised, models.NewDate(2026, 2, 23), "Great Lent", "Holy Week", models.NewDate(2026, 4, 12)},
+		{"Holy Friday", models.StyleRevised, models.NewDate(2026, 4, 10), "Holy Week", "Apostles' Fast", models.NewDate(2026, 4, 12)},
+		{"before the Dormition Fast", models.StyleRevised, models.NewDate(2026, 7, 15), "", "Dormition Fast", models.NewDate(2026, 7, 16)},
+		{"a Friday", models.StyleRevised, models.NewDate(2026, 10, 16), "", "Nativity Fast", models.NewDate(2026, 10, 17)},
+		{"after the Nativity Fast", models.StyleRevised, models.NewDate(2026, 12, 28), "", "Cheesefare Week", models.NewDate(2026, 12, 29)},
+		{"Julian Nativity Fast in the new year", models.StyleJulian, models.NewDate(2027, 1, 3), "Nativity Fast", "Cheesefare Week", models.NewDate(2027, 1, 7)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(mustLoad(t), tt.style).FastingStatus(tt.date)
+			name := func(p *models.FastingPeriod) string {
+				if p == nil {
+					return ""
+				}
+				return p.Name
+			}
+			if got := name(s.Current); got != tt.current {
+				t.Errorf("current = %q, want %q", got, tt.current)
+			}
+			if got := name(s.Next); got != tt.next {
+				t.Errorf("next = %q, want %q", got, tt.next)
+			}
+			if s.NextFastFree != tt.fastFree {
+				t.Errorf("next fast-free day = %s, want %s", s.NextFastFree, tt.fastFree)
+			}
+		})
+	}
+}
+
+func TestFastingStatus_DayInfo(t *testing.T) {
+	info := New(mustLoad(t), models.StyleRevised).GetDayInfo(models.NewDate(2026, 11, 20))
+	if p := info.FastingStatus.Current; p == nil || p.Name != "Nativity Fast" || p.Days() != 40 {
+		t.Errorf("current period = %+v, want the 40-day Nativity Fast", p)
+	}
+	if info.FastingStatus.NextFastFree != (models.Date{}) {
+		t.Error("GetDayInfo should leave the next fast-free day to FastingStatus")
+	}
+}
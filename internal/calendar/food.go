@@ -0,0 +1,85 @@
+package calendar
+
+import "greekOrtho/internal/models"
+
+// LookupFood finds a food item by its English or Greek name or any alias,
+// ignoring case and Greek accents.
+func (c *Calendar) LookupFood(name string) (models.FoodItem, bool) {
+	want := normalizeName(name)
+	for _, f := range c.data.Foods {
+		if normalizeName(f.Name) == want || normalizeName(f.GreekName) == want {
+			return f, true
+		}
+		for _, a := range f.Aliases {
+			if normalizeName(a) == want {
+				return f, true
+			}
+		}
+	}
+	return models.FoodItem{}, false
+}
+
+// CheckFood answers whether item may be eaten on date. It follows the
+// personal fast when a fasting profile is set, else the canonical fast; either
+// way the verdict names the rule that decides the canonical level.
+func (c *Calendar) CheckFood(item models.FoodItem, date models.Date) models.FoodVerdict {
+	e := c.ExplainFasting(date)
+	foods, _ := e.Level.Permits()
+
+	var personal *models.PersonalFast
+	if c.fastingProfile != nil {
+		pf := ResolvePersonalFast(date, e.Level, *c.fastingProfile)
+		personal = &pf
+		foods = pf.Foods
+	}
+
+	forbidden := forbiddenFoods(item, foods)
+	return models.FoodVerdict{
+		Item:      item,
+		Date:      date,
+		Permitted: len(forbidden) == 0,
+		Forbidden: forbidden,
+		Level:     e.Level,
+		Rule:      decidingRule(e),
+		Reason:    e.Reason,
+		Personal:  personal,
+	}
+}
+
+// PermittedDays returns the days from start to end inclusive on which item
+// may be eaten, judged as CheckFood does.
+func (c *Calendar) PermittedDays(item models.FoodItem, start, end models.Date) []models.Date {
+	var days []models.Date
+	for d := start; !d.After(end); d = d.AddDays(1) {
+		if c.CheckFood(item, d).Permitted {
+			days = append(days, d)
+		}
+	}
+	return days
+}
+
+// forbiddenFoods returns the item's categories that foods does not include.
+func forbiddenFoods(item models.FoodItem, foods models.FoodSet) []models.Food {
+	return (item.Foods() &^ foods).Foods()
+}
+
+// decidingRule names what set a day's fasting level: the last feast override
+// applied, else the last feast relaxation, else the chosen fasting rule.
+func decidingRule(e models.FastingExplanation) string {
+	for i := len(e.FeastOverrides) - 1; i >= 0; i-- {
+		if e.FeastOverrides[i].Accepted {
+			return e.FeastOverrides[i].Feast
+		}
+	}
+	for i := len(e.Relaxations) - 1; i >= 0; i-- {
+		if e.Relaxations[i].Accepted {
+			return e.Relaxations[i].Feast
+		}
+	}
+	for _, cand := range e.Candidates {
+		if cand.Chosen {
+			return cand.Rule.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"slices"
+	"testing"
+)
+
+func TestLookupFood(t *testing.T) {
+	cal := New(mustLoad(t), models.StyleRevised)
+	for _, name := range []string{"cheese", "Cheese", "τυρί", "ΤΥΡΙ", "feta", "φετα"} {
+		if f, ok := cal.LookupFood(name); !ok || f.Name != "cheese" {
+			t.Errorf("LookupFood(%q) = %q, %v; want cheese", name, f.Name, ok)
+		}
+	}
+	if _, ok := cal.LookupFood("unobtainium"); ok {
+		t.Error("expected an unknown food not to be found")
+	}
+}
+
+func TestFoodData(t *testing.T) {
+	d := mustLoad(t)
+	seen := make(map[string]string)
+	for _, f := range d.Foods {
+		if f.Name == "" || f.GreekName == "" || len(f.Categories) == 0 {
+			t.Errorf("incomplete food item %+v", f)
+		}
+		if len(f.Foods().Foods()) != len(f.Categories) {
+			t.Errorf("%s: unknown or repeated category in %v", f.Name, f.Categories)
+		}
+		for _, n := range append([]string{f.Name, f.GreekName}, f.Aliases...) {
+			key := normalizeName(n)
+			if other, ok := seen[key]; ok {
+				t.Errorf("%q names both %s and %s", n, other, f.Name)
+			}
+			seen[key] = f.Name
+		}
+	}
+}
+
+func TestCheckFood(t *testing.T) {
+	cal := New(mustLoad(t), models.StyleRevised)
+	food := func(name string) models.FoodItem {
+		f, ok := cal.LookupFood(name)
+		if !ok {
+			t.Fatalf("no food %q", name)
+		}
+		return f
+	}
+
+	tests := []struct {
+		name      string
+		food      string
+		date      models.Date
+		permitted bool
+		forbidden []models.Food
+		rule      string
+	}{
+		{"cheese in Lent", "cheese", models.NewDate(2026, 3, 2), false, []models.Food{models.FoodDairy}, "Great Lent"},
+		{"cheese in Cheesefare Week", "cheese", models.NewDate(2026, 2, 18), true, nil, "Cheesefare Week"},
+		{"octopus in Lent", "octopus", models.NewDate(2026, 3, 2), true, nil, "Great Lent"},
+		{"fish on the Annunciation", "fish", models.NewDate(2026, 3, 25), true, nil, "Annunciation of the Theotokos"},
+		{"bread on Holy Friday", "bread", models.NewDate(2026, 4, 10), false, []models.Food{models.FoodDry}, "Holy Friday"},
+		{"meat off a fast", "meat", models.NewDate(2026, 7, 6), true, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := cal.CheckFood(food(tt.food), tt.date)
+			if v.Permitted != tt.permitted {
+				t.Errorf("permitted = %v, want %v (level %s)", v.Permitted, tt.permitted, v.Level)
+			}
+			if !slices.Equal(v.Forbidden, tt.forbidden) {
+				t.Errorf("forbidden = %v, want %v", v.Forbidden, tt.forbidden)
+			}
+			if v.Rule != tt.rule {
+				t.Errorf("rule = %q, want %q", v.Rule, tt.rule)
+			}
+			if v.Personal != nil {
+				t.Error("expected no personal fast without a profile")
+			}
+		})
+	}
+
+	cal.SetFastingProfile(&models.FastingProfile{Practice: models.PracticeLenient})
+	v := cal.CheckFood(food("olive oil"), models.NewDate(2026, 3, 2))
+	if !v.Permitted || v.Personal == nil || v.Level != models.FastingStrict {
+		t.Errorf("olive oil under a lenient profile = %+v, want permitted with the strict fast canonical", v)
+	}
+}
+
+func TestPermittedDays(t *testing.T) {
+	cal := New(mustLoad(t), models.StyleRevised)
+	cheese, _ := cal.LookupFood("cheese")
+
+	// Cheesefare Week, then Great Lent from Clean Monday.
+	days := cal.PermittedDays(cheese, models.NewDate(2026, 2, 16), models.NewDate(2026, 2, 28))
+	if len(days) != 7 || days[0] != models.NewDate(2026, 2, 16) || days[6] != models.NewDate(2026, 2, 22) {
+		t.Errorf("PermittedDays = %v, want Feb 16-22", days)
+	}
+}
@@ -0,0 +1,40 @@
+package calendar
+
+import "greekOrtho/internal/models"
+
+// ResolveKneeling determines whether the faithful kneel, make prostrations or
+// do not kneel on a given date, and why. As with fasting, the highest-priority
+// matching rule wins and its weekday overrides apply; days no rule covers are
+// ordinary kneeling days. Fixed-date periods are matched on the calendar style's date.
+func ResolveKneeling(date models.Date, pascha models.Date, style models.CalendarStyle, rules []models.KneelingRule) (models.Kneeling, string) {
+	daysFromPascha := date.Sub(pascha)
+	fixed := fixedDate(date, style)
+
+	var bestRule *models.KneelingRule
+	bestPriority := -1
+
+	for i := range rules {
+		r := &rules[i]
+		if !ruleMatches(date, fixed, daysFromPascha, pascha, style, &r.DateSpan) {
+			continue
+		}
+		if r.Priority > bestPriority {
+			bestPriority = r.Priority
+			bestRule = r
+		}
+	}
+
+	if bestRule == nil {
+		return models.KneelingPermitted, ""
+	}
+
+	for _, ov := range bestRule.WeekdayOverrides {
+		if date.Weekday() == ov.Weekday {
+			if ov.Description != "" {
+				return ov.Level, ov.Description
+			}
+			return ov.Level, bestRule.Description
+		}
+	}
+	return bestRule.Level, bestRule.Description
+}
@@ -0,0 +1,44 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"testing"
+)
+
+func TestKneeling(t *testing.T) {
+	cal := newCalendar(t)
+	tests := []struct {
+		date models.Date
+		want models.Kneeling
+	}{
+		// Pascha 2026 is April 12
+		{models.NewDate(2026, 2, 23), models.KneelingProstrations}, // Clean Monday
+		{models.NewDate(2026, 2, 28), models.KneelingPermitted},    // 1st Saturday of Lent
+		{models.NewDate(2026, 3, 1), models.KneelingNone},          // Sunday of Orthodoxy
+		{models.NewDate(2026, 4, 8), models.KneelingProstrations},  // Holy Wednesday
+		{models.NewDate(2026, 4, 9), models.KneelingPermitted},     // Holy Thursday
+		{models.NewDate(2026, 4, 15), models.KneelingNone},         // Bright Wednesday
+		{models.NewDate(2026, 5, 27), models.KneelingNone},         // a weekday before Pentecost
+		{models.NewDate(2026, 5, 31), models.KneelingNone},         // Pentecost
+		{models.NewDate(2026, 6, 1), models.KneelingPermitted},     // Monday of the Holy Spirit
+		{models.NewDate(2026, 10, 18), models.KneelingNone},        // a Sunday
+		{models.NewDate(2026, 10, 20), models.KneelingPermitted},   // a Tuesday
+		{models.NewDate(2026, 12, 30), models.KneelingNone},        // Twelve Days
+		{models.NewDate(2027, 1, 7), models.KneelingPermitted},     // after Theophany
+	}
+
+	for _, tt := range tests {
+		info := cal.GetDayInfo(tt.date)
+		if info.Kneeling != tt.want {
+			t.Errorf("%s: kneeling %q, want %q (%s)", tt.date, info.Kneeling, tt.want, info.KneelingReason)
+		}
+	}
+}
+
+func TestKneeling_SaturdayOverrideReason(t *testing.T) {
+	cal := newCalendar(t)
+	info := cal.GetDayInfo(models.NewDate(2026, 2, 28))
+	if info.KneelingReason != "Lenten Saturday, without prostrations" {
+		t.Errorf("unexpected reason %q", info.KneelingReason)
+	}
+}
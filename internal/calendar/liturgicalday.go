@@ -0,0 +1,110 @@
+package calendar
+
+import (
+	"fmt"
+	"greekOrtho/internal/models"
+)
+
+// triodionWeeks names the three weeks before Lent by their opening Sunday.
+var triodionWeeks = []struct{ sunday, week string }{
+	{"Sunday of the Publican and the Pharisee", "the Week of the Publican and the Pharisee"},
+	{"Sunday of the Prodigal Son", "Meatfare Week"},
+	{"Meatfare Sunday", "Cheesefare Week"},
+}
+
+// holyWeekDays are the titles of Lazarus Saturday through Holy Saturday.
+var holyWeekDays = []string{
+	"Lazarus Saturday", "Palm Sunday", "Holy Monday", "Holy Tuesday",
+	"Holy Wednesday", "Holy Thursday", "Holy Friday", "Holy Saturday",
+}
+
+// resolveLiturgicalDay names date by its place in the moveable cycle of pascha.
+// Days before this year's Triodion belong to the weeks after the previous
+// Pentecost, counted from prevPascha.
+func resolveLiturgicalDay(date models.Date, pascha, prevPascha models.Date, style models.CalendarStyle) models.LiturgicalDay {
+	n := date.Sub(pascha)
+	weekday := date.Weekday().String()
+
+	switch {
+	case n < -70:
+		return afterPentecostDay(date, prevPascha, style)
+
+	case n < -48: // Triodion
+		week := (n+70)/7 + 1
+		if n == -49 {
+			return models.LiturgicalDay{Period: models.PeriodTriodion, Week: week - 1, Title: "Cheesefare Sunday"}
+		}
+		w := triodionWeeks[week-1]
+		title := weekday + " of " + w.week
+		if date.Weekday() == 0 {
+			title = w.sunday
+		}
+		return models.LiturgicalDay{Period: models.PeriodTriodion, Week: week, Title: title}
+
+	case n < -8: // Great Lent; its Sundays close each week
+		week := (n+48)/7 + 1
+		title := fmt.Sprintf("%s of the %s Week of Lent", weekday, ordinal(week))
+		switch {
+		case n == -48:
+			title = "Clean Monday"
+		case date.Weekday() == 0:
+			title = fmt.Sprintf("%s Sunday of Lent", ordinal(week))
+		}
+		return models.LiturgicalDay{Period: models.PeriodGreatLent, Week: week, Title: title}
+
+	case n < 0:
+		return models.LiturgicalDay{Period: models.PeriodHolyWeek, Title: holyWeekDays[n+8]}
+
+	case n < 50: // Pentecostarion; from Thomas Sunday each Sunday opens its week
+		week := n/7 + 1
+		title := fmt.Sprintf("%s of the %s Week after Pascha", weekday, ordinal(week))
+		switch {
+		case n == 0:
+			title = "Pascha"
+		case n < 7:
+			title = "Bright " + weekday
+		case n == 49:
+			title = "Pentecost"
+		case date.Weekday() == 0:
+			title = fmt.Sprintf("%s Sunday after Pascha", ordinal(week))
+		}
+		return models.LiturgicalDay{Period: models.PeriodPentecostarion, Week: week, Title: title}
+
+	default:
+		day := afterPentecostDay(date, pascha, style)
+		if n == 50 {
+			day.Title = "Monday of the Holy Spirit"
+		}
+		return day
+	}
+}
+
+// afterPentecostDay names a day after Pentecost by the week of its gospel series,
+// e.g. "14th Sunday of Luke" or "Tuesday of the 3rd Week of Matthew".
+func afterPentecostDay(date models.Date, pascha models.Date, style models.CalendarStyle) models.LiturgicalDay {
+	series, seriesWeek := gospelWeek(date, pascha, style)
+	title := fmt.Sprintf("%s of the %s Week of %s", date.Weekday(), ordinal(seriesWeek), series)
+	if date.Weekday() == 0 {
+		title = fmt.Sprintf("%s Sunday of %s", ordinal(seriesWeek), series)
+	}
+	return models.LiturgicalDay{
+		Period: models.PeriodAfterPentecost,
+		Week:   (date.Sub(pascha)-50)/7 + 1,
+		Title:  title,
+	}
+}
+
+// ordinal returns n with its English ordinal suffix, e.g. "1st", "12th", "23rd".
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -0,0 +1,58 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"testing"
+)
+
+func TestLiturgicalDay(t *testing.T) {
+	cal := newCalendar(t)
+	// Pascha 2026 is April 12; the Sunday after the Elevation is September 20
+	tests := []struct {
+		date   models.Date
+		period models.LiturgicalPeriod
+		week   int
+		title  string
+	}{
+		{models.NewDate(2026, 2, 1), models.PeriodTriodion, 1, "Sunday of the Publican and the Pharisee"},
+		{models.NewDate(2026, 2, 14), models.PeriodTriodion, 2, "Saturday of Meatfare Week"},
+		{models.NewDate(2026, 2, 22), models.PeriodTriodion, 3, "Cheesefare Sunday"},
+		{models.NewDate(2026, 2, 23), models.PeriodGreatLent, 1, "Clean Monday"},
+		{models.NewDate(2026, 3, 1), models.PeriodGreatLent, 1, "1st Sunday of Lent"},
+		{models.NewDate(2026, 3, 10), models.PeriodGreatLent, 3, "Tuesday of the 3rd Week of Lent"},
+		{models.NewDate(2026, 4, 3), models.PeriodGreatLent, 6, "Friday of the 6th Week of Lent"},
+		{models.NewDate(2026, 4, 4), models.PeriodHolyWeek, 0, "Lazarus Saturday"},
+		{models.NewDate(2026, 4, 10), models.PeriodHolyWeek, 0, "Holy Friday"},
+		{models.NewDate(2026, 4, 12), models.PeriodPentecostarion, 1, "Pascha"},
+		{models.NewDate(2026, 4, 15), models.PeriodPentecostarion, 1, "Bright Wednesday"},
+		{models.NewDate(2026, 4, 19), models.PeriodPentecostarion, 2, "2nd Sunday after Pascha"},
+		{models.NewDate(2026, 5, 14), models.PeriodPentecostarion, 5, "Thursday of the 5th Week after Pascha"},
+		{models.NewDate(2026, 5, 31), models.PeriodPentecostarion, 8, "Pentecost"},
+		{models.NewDate(2026, 6, 1), models.PeriodAfterPentecost, 1, "Monday of the Holy Spirit"},
+		{models.NewDate(2026, 6, 7), models.PeriodAfterPentecost, 1, "1st Sunday of Matthew"},
+		{models.NewDate(2026, 6, 9), models.PeriodAfterPentecost, 2, "Tuesday of the 2nd Week of Matthew"},
+		{models.NewDate(2026, 9, 20), models.PeriodAfterPentecost, 16, "16th Sunday of Matthew"},
+		{models.NewDate(2026, 9, 21), models.PeriodAfterPentecost, 17, "Monday of the 1st Week of Luke"},
+		{models.NewDate(2026, 9, 27), models.PeriodAfterPentecost, 17, "1st Sunday of Luke"},
+		{models.NewDate(2026, 10, 18), models.PeriodAfterPentecost, 20, "4th Sunday of Luke"},
+		// Before the 2027 Triodion the weeks after Pentecost 2026 continue
+		{models.NewDate(2026, 12, 27), models.PeriodAfterPentecost, 30, "14th Sunday of Luke"},
+		{models.NewDate(2027, 1, 12), models.PeriodAfterPentecost, 33, "Tuesday of the 17th Week of Luke"},
+	}
+
+	for _, tt := range tests {
+		got := cal.GetDayInfo(tt.date).LiturgicalDay
+		if got.Period != tt.period || got.Week != tt.week || got.Title != tt.title {
+			t.Errorf("%s: got %+v, want {%s %d %s}", tt.date, got, tt.period, tt.week, tt.title)
+		}
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := map[int]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 13: "13th", 21: "21st", 22: "22nd", 33: "33rd", 111: "111th"}
+	for n, want := range tests {
+		if got := ordinal(n); got != want {
+			t.Errorf("ordinal(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"time"
+)
+
+// eveFeasts are the feasts whose eve is kept with a Vesperal Liturgy of St. Basil.
+var eveFeasts = []struct {
+	month time.Month
+	day   int
+	name  string
+}{
+	{time.December, 25, "the Nativity"},
+	{time.January, 6, "Theophany"},
+}
+
+// ResolveLiturgy determines which Divine Liturgy is served on a date and why.
+//
+//   - St. Basil: the Sundays of Lent, Holy Thursday and Saturday, Jan 1, and the
+//     eves of the Nativity and Theophany. When such an eve falls on a Saturday or
+//     Sunday, St. Basil's Liturgy moves to the feast itself.
+//   - Presanctified: Wednesdays and Fridays of Lent and Holy Monday to Wednesday.
+//   - None: other Lenten weekdays, Wednesday and Friday of Cheesefare Week and
+//     Holy Friday, unless the Annunciation falls on them.
+//
+// Fixed dates are matched on the calendar style's date.
+func ResolveLiturgy(date models.Date, pascha models.Date, style models.CalendarStyle) (models.Liturgy, string) {
+	daysFromPascha := date.Sub(pascha)
+	fixed := fixedDate(date, style)
+	weekday := date.Weekday()
+	annunciation := fixed.Month == time.March && fixed.Day == 25
+
+	// Holy Week
+	switch daysFromPascha {
+	case -6, -5, -4:
+		if annunciation {
+			return models.LiturgyChrysostom, "The Annunciation"
+		}
+		return models.LiturgyPresanctified, "Holy Week"
+	case -3:
+		return models.LiturgyBasil, "Vesperal Liturgy of Holy Thursday"
+	case -2:
+		if annunciation {
+			return models.LiturgyChrysostom, "The Annunciation on Holy Friday"
+		}
+		return models.LiturgyNone, "Holy Friday"
+	case -1:
+		return models.LiturgyBasil, "Vesperal Liturgy of Holy Saturday"
+	case -53, -51:
+		return models.LiturgyNone, "Wednesday and Friday of Cheesefare Week"
+	}
+
+	// Great Lent, Clean Monday to the Friday before Lazarus Saturday
+	if daysFromPascha >= -48 && daysFromPascha <= -9 {
+		switch {
+		case weekday == time.Sunday:
+			return models.LiturgyBasil, "Sunday of Great Lent"
+		case weekday == time.Saturday:
+			return models.LiturgyChrysostom, ""
+		case annunciation:
+			return models.LiturgyChrysostom, "The Annunciation"
+		case weekday == time.Wednesday || weekday == time.Friday:
+			return models.LiturgyPresanctified, "Lenten weekday"
+		default:
+			return models.LiturgyNone, "Lenten weekday"
+		}
+	}
+
+	if fixed.Month == time.January && fixed.Day == 1 {
+		return models.LiturgyBasil, "Feast of St. Basil the Great"
+	}
+
+	for _, f := range eveFeasts {
+		if fixed.Month != f.month {
+			continue
+		}
+		switch fixed.Day {
+		case f.day:
+			// The eve was a Saturday or Sunday
+			if weekday == time.Sunday || weekday == time.Monday {
+				return models.LiturgyBasil, "Moved from the eve of " + f.name + ", which fell on a weekend"
+			}
+		case f.day - 1:
+			if weekday == time.Saturday || weekday == time.Sunday {
+				return models.LiturgyChrysostom, "St. Basil's Liturgy moves to " + f.name + " when its eve falls on a weekend"
+			}
+			return models.LiturgyBasil, "Vesperal Liturgy on the eve of " + f.name
+		}
+	}
+
+	return models.LiturgyChrysostom, ""
+}
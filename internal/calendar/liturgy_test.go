@@ -0,0 +1,64 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"testing"
+)
+
+func TestResolveLiturgy(t *testing.T) {
+	// Pascha 2026 is April 12
+	p2026 := models.NewDate(2026, 4, 12)
+	tests := []struct {
+		name   string
+		date   models.Date
+		pascha models.Date
+		want   models.Liturgy
+	}{
+		{"ordinary Sunday", models.NewDate(2026, 10, 18), p2026, models.LiturgyChrysostom},
+		{"Cheesefare Wednesday", models.NewDate(2026, 2, 18), p2026, models.LiturgyNone},
+		{"Cheesefare Thursday", models.NewDate(2026, 2, 19), p2026, models.LiturgyChrysostom},
+		{"Cheesefare Friday", models.NewDate(2026, 2, 20), p2026, models.LiturgyNone},
+		{"Clean Monday", models.NewDate(2026, 2, 23), p2026, models.LiturgyNone},
+		{"Lenten Tuesday", models.NewDate(2026, 3, 3), p2026, models.LiturgyNone},
+		{"Lenten Wednesday", models.NewDate(2026, 3, 4), p2026, models.LiturgyPresanctified},
+		{"Lenten Friday", models.NewDate(2026, 3, 6), p2026, models.LiturgyPresanctified},
+		{"Lenten Saturday", models.NewDate(2026, 3, 7), p2026, models.LiturgyChrysostom},
+		{"Sunday of Orthodoxy", models.NewDate(2026, 3, 1), p2026, models.LiturgyBasil},
+		{"Annunciation on a Lenten Wednesday", models.NewDate(2026, 3, 25), p2026, models.LiturgyChrysostom},
+		{"Lazarus Saturday", models.NewDate(2026, 4, 4), p2026, models.LiturgyChrysostom},
+		{"Holy Monday", models.NewDate(2026, 4, 6), p2026, models.LiturgyPresanctified},
+		{"Holy Thursday", models.NewDate(2026, 4, 9), p2026, models.LiturgyBasil},
+		{"Holy Friday", models.NewDate(2026, 4, 10), p2026, models.LiturgyNone},
+		{"Holy Saturday", models.NewDate(2026, 4, 11), p2026, models.LiturgyBasil},
+		{"Pascha", p2026, p2026, models.LiturgyChrysostom},
+		{"St. Basil", models.NewDate(2026, 1, 1), p2026, models.LiturgyBasil},
+		// Eve on a weekday: Vesperal Liturgy of St. Basil on the eve
+		{"Nativity Eve 2024 (Tue)", models.NewDate(2024, 12, 24), models.NewDate(2024, 5, 5), models.LiturgyBasil},
+		{"Nativity 2024 (Wed)", models.NewDate(2024, 12, 25), models.NewDate(2024, 5, 5), models.LiturgyChrysostom},
+		{"Theophany Eve 2026 (Mon)", models.NewDate(2026, 1, 5), p2026, models.LiturgyBasil},
+		// Feast on Sunday or Monday: St. Basil moves to the feast
+		{"Nativity Eve 2022 (Sat)", models.NewDate(2022, 12, 24), models.NewDate(2022, 4, 24), models.LiturgyChrysostom},
+		{"Nativity 2022 (Sun)", models.NewDate(2022, 12, 25), models.NewDate(2022, 4, 24), models.LiturgyBasil},
+		{"Nativity Eve 2023 (Sun)", models.NewDate(2023, 12, 24), models.NewDate(2023, 4, 16), models.LiturgyChrysostom},
+		{"Nativity 2023 (Mon)", models.NewDate(2023, 12, 25), models.NewDate(2023, 4, 16), models.LiturgyBasil},
+		{"Theophany Eve 2025 (Sun)", models.NewDate(2025, 1, 5), models.NewDate(2025, 4, 20), models.LiturgyChrysostom},
+		{"Theophany 2025 (Mon)", models.NewDate(2025, 1, 6), models.NewDate(2025, 4, 20), models.LiturgyBasil},
+	}
+
+	for _, tt := range tests {
+		got, _ := ResolveLiturgy(tt.date, tt.pascha, models.StyleRevised)
+		if got != tt.want {
+			t.Errorf("%s (%s): got %s, want %s", tt.name, tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestResolveLiturgy_Julian(t *testing.T) {
+	cal := New(mustLoad(t), models.StyleJulian)
+
+	// Dec 24 O.S. = Wed Jan 6, 2027 N.S.; Theophany N.S. is an ordinary day
+	info := cal.GetDayInfo(models.NewDate(2027, 1, 6))
+	if info.Liturgy != models.LiturgyBasil || info.LiturgyNote != "Vesperal Liturgy on the eve of the Nativity" {
+		t.Errorf("got %s (%s), want the Vesperal Liturgy of St. Basil", info.Liturgy, info.LiturgyNote)
+	}
+}
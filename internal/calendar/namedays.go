@@ -0,0 +1,120 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"strings"
+)
+
+// nameDaysOn returns the name days kept on a day with the given feasts and
+// saints. A saint listed under a feast's name adds nothing of its own, so a
+// transferred feast (St. George after Pascha) takes its name days with it.
+func (c *Calendar) nameDaysOn(feasts []models.Feast, saints []models.Saint) []models.NameDay {
+	var result []models.NameDay
+	seen := make(map[string]bool)
+	add := func(commemoration string) {
+		for _, n := range c.nameDays[commemoration] {
+			if !seen[n.Name] {
+				seen[n.Name] = true
+				result = append(result, n)
+			}
+		}
+	}
+
+	for _, f := range feasts {
+		add(f.Name)
+	}
+	for _, s := range saints {
+		if !c.feastNames[s.Name] {
+			add(s.Name)
+		}
+	}
+	return result
+}
+
+// LookupNameDay finds the name day of a given name in any of its forms
+// (English, Greek or a variant), ignoring case and Greek accents.
+func (c *Calendar) LookupNameDay(name string) (models.NameDay, bool) {
+	want := normalizeName(name)
+	for _, n := range c.data.NameDays {
+		if normalizeName(n.Name) == want || normalizeName(n.GreekName) == want {
+			return n, true
+		}
+		for _, v := range n.Variants {
+			if normalizeName(v) == want {
+				return n, true
+			}
+		}
+	}
+	return models.NameDay{}, false
+}
+
+// NameDayDates returns the days of the given year on which nd is celebrated.
+func (c *Calendar) NameDayDates(nd models.NameDay, year int) []models.Date {
+	var dates []models.Date
+	end := models.NewDate(year, 12, 31)
+	for d := models.NewDate(year, 1, 1); !d.After(end); d = d.AddDays(1) {
+		fixed := fixedDate(d, c.style)
+		ly := c.year(year)
+		for _, n := range c.nameDaysOn(c.findFeasts(d, fixed, ly), c.findSaints(d, fixed, ly)) {
+			if n.Name == nd.Name {
+				dates = append(dates, d)
+				break
+			}
+		}
+	}
+	return dates
+}
+
+// SetContacts matches each contact's given name (or, without one, the first
+// word of the full name) to a name day, so GetDayInfo lists the contacts
+// whose name day it is. It returns the contacts with no known name day.
+// Call it before the Calendar is used concurrently.
+func (c *Calendar) SetContacts(contacts []models.Contact) []models.Contact {
+	c.contacts = make(map[string][]models.Contact)
+	var unmatched []models.Contact
+	for _, ct := range contacts {
+		nd, ok := c.contactNameDay(ct)
+		if !ok {
+			unmatched = append(unmatched, ct)
+			continue
+		}
+		ct.NameDay = nd.Name
+		c.contacts[nd.Name] = append(c.contacts[nd.Name], ct)
+	}
+	return unmatched
+}
+
+// contactNameDay finds the name day of a contact, trying each word of the
+// given name ("Maria Eleni") before the first word of the full name.
+func (c *Calendar) contactNameDay(ct models.Contact) (models.NameDay, bool) {
+	candidates := strings.Fields(ct.GivenName)
+	if fields := strings.Fields(ct.Name); len(fields) > 0 {
+		candidates = append(candidates, fields[0])
+	}
+	for _, name := range candidates {
+		if nd, ok := c.LookupNameDay(name); ok {
+			return nd, true
+		}
+	}
+	return models.NameDay{}, false
+}
+
+// contactsOn returns the contacts celebrating one of the given name days.
+func (c *Calendar) contactsOn(nameDays []models.NameDay) []models.Contact {
+	var result []models.Contact
+	for _, n := range nameDays {
+		result = append(result, c.contacts[n.Name]...)
+	}
+	return result
+}
+
+// greekAccents maps accented and final Greek letters to their plain forms.
+var greekAccents = strings.NewReplacer(
+	"ά", "α", "έ", "ε", "ή", "η", "ί", "ι", "ϊ", "ι", "ΐ", "ι",
+	"ό", "ο", "ύ", "υ", "ϋ", "υ", "ΰ", "υ", "ώ", "ω", "ς", "σ",
+)
+
+// normalizeName folds a name for comparison: lower case, without Greek accents.
+func normalizeName(name string) string {
+	return greekAccents.Replace(strings.ToLower(strings.TrimSpace(name)))
+}
@@ -0,0 +1,128 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"slices"
+	"testing"
+)
+
+func TestLookupNameDay(t *testing.T) {
+	cal := newCalendar(t)
+	tests := []struct {
+		query, want string
+	}{
+		{"George", "George"},
+		{"giorgos", "George"},
+		{"Γεώργιος", "George"},
+		{"ΓΙΩΡΓΟΣ", "George"}, // capitals without accents
+		{"Eleni", "Helen"},
+		{"Konstantinos", "Constantine"},
+		{"Κώστας", "Constantine"},
+	}
+	for _, tt := range tests {
+		nd, ok := cal.LookupNameDay(tt.query)
+		if !ok || nd.Name != tt.want {
+			t.Errorf("LookupNameDay(%q) = %q, %v; want %q", tt.query, nd.Name, ok, tt.want)
+		}
+	}
+	if _, ok := cal.LookupNameDay("Zebedee"); ok {
+		t.Error("expected no name day for Zebedee")
+	}
+}
+
+func TestNameDayDates(t *testing.T) {
+	cal := newCalendar(t)
+	tests := []struct {
+		name string
+		year int
+		want []models.Date
+	}{
+		{"George", 2026, []models.Date{models.NewDate(2026, 4, 23)}},
+		// Pascha 2024 is May 5: St. George falls in Lent and moves to Bright Monday
+		{"George", 2024, []models.Date{models.NewDate(2024, 5, 6)}},
+		{"Helen", 2026, []models.Date{models.NewDate(2026, 5, 21)}},
+		// The Myrrhbearers are two weeks after Pascha
+		{"Martha", 2026, []models.Date{models.NewDate(2026, 4, 26)}},
+		{"Martha", 2027, []models.Date{models.NewDate(2027, 5, 16)}},
+	}
+	for _, tt := range tests {
+		nd, ok := cal.LookupNameDay(tt.name)
+		if !ok {
+			t.Fatalf("no name day for %s", tt.name)
+		}
+		got := cal.NameDayDates(nd, tt.year)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s %d: got %v, want %v", tt.name, tt.year, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s %d: got %v, want %v", tt.name, tt.year, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestNameDays_DayInfo(t *testing.T) {
+	cal := newCalendar(t)
+	info := cal.GetDayInfo(models.NewDate(2026, 8, 15))
+	names := make(map[string]bool)
+	for _, n := range info.NameDays {
+		names[n.Name] = true
+	}
+	for _, want := range []string{"Mary", "Panagiotis", "Despina"} {
+		if !names[want] {
+			t.Errorf("Aug 15: expected %s among %v", want, info.NameDays)
+		}
+	}
+}
+
+func TestNameDays_Commemorations(t *testing.T) {
+	cal := newCalendar(t)
+	known := make(map[string]bool)
+	for name := range cal.feastNames {
+		known[name] = true
+	}
+	for _, s := range cal.data.Saints {
+		known[s.Name] = true
+	}
+	for _, n := range cal.data.NameDays {
+		for _, c := range n.Commemorations {
+			if !known[c] {
+				t.Errorf("%s: no feast or saint named %q", n.Name, c)
+			}
+		}
+	}
+}
+
+func TestSetContacts(t *testing.T) {
+	cal := newCalendar(t)
+	unmatched := cal.SetContacts([]models.Contact{
+		{Name: "Giorgos Papadopoulos", GivenName: "Giorgos"},
+		{Name: "Κώστας Ιωάννου"},                              // no given name: first word of the name
+		{Name: "Anna Maria Pappa", GivenName: "Xanthe Maria"}, // first known word of the given name
+		{Name: "Jane Doe", GivenName: "Jane"},
+	})
+	if len(unmatched) != 1 || unmatched[0].Name != "Jane Doe" {
+		t.Errorf("unmatched = %+v, want Jane Doe only", unmatched)
+	}
+
+	tests := []struct {
+		date models.Date
+		want []string
+	}{
+		{models.NewDate(2026, 4, 23), []string{"Giorgos Papadopoulos"}},
+		{models.NewDate(2026, 5, 21), []string{"Κώστας Ιωάννου"}},
+		{models.NewDate(2026, 8, 15), []string{"Anna Maria Pappa"}},
+		{models.NewDate(2026, 8, 16), nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, c := range cal.GetDayInfo(tt.date).Contacts {
+			got = append(got, c.Name)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: contacts = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+}
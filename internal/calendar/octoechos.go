@@ -0,0 +1,72 @@
+package calendar
+
+import (
+	"fmt"
+	"greekOrtho/internal/data"
+	"greekOrtho/internal/models"
+)
+
+// brightWeekTones are the tones of Pascha and each day of Bright Week; the
+// grave tone is skipped.
+var brightWeekTones = []models.Tone{1, 2, 3, 4, 5, 6, 8}
+
+// pentecostarionEothina are the Matins Gospels of the Sundays from Thomas Sunday
+// to Pentecost, by days from Pascha.
+var pentecostarionEothina = map[int]int{
+	7:  1,  // Thomas
+	14: 3,  // Myrrhbearers
+	21: 4,  // Paralytic
+	28: 7,  // Samaritan Woman
+	35: 8,  // Blind Man
+	42: 10, // Fathers of the First Council
+	49: 9,  // Pentecost
+}
+
+// resolveTone returns the tone of the week for date. The cycle of eight tones
+// begins with Tone 1 on Thomas Sunday and runs until the Friday before Lazarus
+// Saturday of the next year; from Lazarus Saturday to Holy Saturday there is
+// none, and each day of Bright Week has its own tone.
+func resolveTone(date models.Date, pascha, prevPascha models.Date) models.Tone {
+	daysFromPascha := date.Sub(pascha)
+	switch {
+	case daysFromPascha >= 7:
+		return models.Tone((daysFromPascha-7)/7%8 + 1)
+	case daysFromPascha >= 0:
+		return brightWeekTones[daysFromPascha]
+	case daysFromPascha >= -8:
+		return 0
+	default:
+		return resolveTone(date, prevPascha, prevPascha)
+	}
+}
+
+// resolveEothinon returns the Eothinon (Resurrection Matins Gospel) of a Sunday,
+// 1–11. The cycle of eleven begins on All Saints Sunday and runs until the fifth
+// Sunday of Lent; the Sundays of the Pentecostarion have their own. Weekdays,
+// Palm Sunday and Pascha have none and return 0.
+func resolveEothinon(date models.Date, pascha, prevPascha models.Date) int {
+	if date.Weekday() != 0 {
+		return 0
+	}
+	daysFromPascha := date.Sub(pascha)
+	switch {
+	case daysFromPascha >= 56:
+		return (daysFromPascha-56)/7%11 + 1
+	case daysFromPascha >= -7:
+		return pentecostarionEothina[daysFromPascha]
+	default:
+		return resolveEothinon(date, prevPascha, prevPascha)
+	}
+}
+
+// matinsReadings returns the Matins Gospel of the given Eothinon, or nil if there is none.
+func matinsReadings(eothinon int, d *data.CalendarData) *models.DayReadings {
+	reading, ok := d.GospelCycle.Eothina[fmt.Sprintf("%d", eothinon)]
+	if !ok {
+		return nil
+	}
+	return &models.DayReadings{
+		Gospel: &reading,
+		Source: "Matins",
+	}
+}
@@ -0,0 +1,94 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"testing"
+)
+
+func TestTone(t *testing.T) {
+	cal := newCalendar(t)
+	tests := []struct {
+		date models.Date
+		want models.Tone
+	}{
+		// Pascha 2026 is April 12
+		{models.NewDate(2026, 4, 12), 1},  // Pascha
+		{models.NewDate(2026, 4, 13), 2},  // Bright Monday
+		{models.NewDate(2026, 4, 17), 6},  // Bright Friday
+		{models.NewDate(2026, 4, 18), 8},  // Bright Saturday: the grave tone is skipped
+		{models.NewDate(2026, 4, 19), 1},  // Thomas Sunday
+		{models.NewDate(2026, 4, 25), 1},  // the Saturday after
+		{models.NewDate(2026, 4, 26), 2},  // Myrrhbearers
+		{models.NewDate(2026, 6, 7), 8},   // All Saints is always Plagal of the Fourth
+		{models.NewDate(2026, 6, 14), 1},  // and the cycle starts again
+		{models.NewDate(2026, 10, 18), 3}, // 27th week after Thomas Sunday
+		// Before Pascha the previous year's cycle continues (Pascha 2025: April 20)
+		{models.NewDate(2026, 3, 29), 1}, // 5th Sunday of Lent, 49 weeks after Thomas Sunday 2025
+		{models.NewDate(2026, 4, 3), 1},  // Friday before Lazarus Saturday
+		{models.NewDate(2026, 4, 4), 0},  // Lazarus Saturday
+		{models.NewDate(2026, 4, 5), 0},  // Palm Sunday
+		{models.NewDate(2026, 4, 11), 0}, // Holy Saturday
+	}
+
+	for _, tt := range tests {
+		if got := cal.GetDayInfo(tt.date).Tone; got != tt.want {
+			t.Errorf("%s: tone %d, want %d", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestEothinon(t *testing.T) {
+	cal := newCalendar(t)
+	tests := []struct {
+		date   models.Date
+		want   int
+		gospel string
+	}{
+		{models.NewDate(2026, 4, 12), 0, ""}, // Pascha
+		{models.NewDate(2026, 4, 19), 1, "Matthew 28:16-20"},
+		{models.NewDate(2026, 5, 10), 7, "John 20:1-10"},     // Samaritan Woman
+		{models.NewDate(2026, 5, 31), 9, "John 20:19-31"},    // Pentecost
+		{models.NewDate(2026, 6, 7), 1, "Matthew 28:16-20"},  // All Saints
+		{models.NewDate(2026, 8, 23), 1, "Matthew 28:16-20"}, // 11 weeks later
+		{models.NewDate(2026, 10, 18), 9, "John 20:19-31"},   // 19 weeks after All Saints
+		{models.NewDate(2026, 10, 19), 0, ""},                // a Monday
+		{models.NewDate(2026, 4, 5), 0, ""},                  // Palm Sunday
+		{models.NewDate(2026, 3, 29), 9, "John 20:19-31"},    // 5th Sunday of Lent, 41 weeks after All Saints 2025
+	}
+
+	for _, tt := range tests {
+		info := cal.GetDayInfo(tt.date)
+		if info.Eothinon != tt.want {
+			t.Errorf("%s: Eothinon %d, want %d", tt.date, info.Eothinon, tt.want)
+		}
+
+		var matins string
+		for _, r := range info.Readings {
+			if r.Source == "Matins" {
+				matins = r.Gospel.Book + " " + r.Gospel.Passage
+			}
+		}
+		if matins != tt.gospel {
+			t.Errorf("%s: Matins Gospel %q, want %q", tt.date, matins, tt.gospel)
+		}
+	}
+}
+
+func TestToneString(t *testing.T) {
+	tests := []struct {
+		tone        models.Tone
+		name, short string
+	}{
+		{1, "Tone 1", "Tone 1"},
+		{4, "Tone 4", "Tone 4"},
+		{5, "Plagal of the First Tone", "Tone pl. 1"},
+		{7, "Grave Tone", "Grave Tone"},
+		{8, "Plagal of the Fourth Tone", "Tone pl. 4"},
+		{0, "", ""},
+	}
+	for _, tt := range tests {
+		if tt.tone.String() != tt.name || tt.tone.Short() != tt.short {
+			t.Errorf("Tone(%d): got %q/%q, want %q/%q", tt.tone, tt.tone.String(), tt.tone.Short(), tt.name, tt.short)
+		}
+	}
+}
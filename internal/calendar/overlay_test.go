@@ -0,0 +1,88 @@
+package calendar
+
+import (
+	"greekOrtho/internal/data"
+	"greekOrtho/internal/models"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeOverlays writes the given overlay files to a temporary directory.
+func writeOverlays(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestUserOverlay_PatronalFeast(t *testing.T) {
+	dir := writeOverlays(t, map[string]string{
+		"fixed_feasts.json": `{"add": [{"name": "Patronal Feast", "rank": "major", "month": 12, "day": 2, "fasting_override": "fish"}]}`,
+		"feast_readings.json": `{"fixed": {"add": {"12/2": {"rank": "major",
+			"epistle": {"book": "Galatians", "passage": "5:22-6:2"},
+			"gospel": {"book": "Luke", "passage": "6:17-23"}}}}}`,
+		"notes.txt": "not an overlay",
+	})
+	d, err := data.LoadWithOverlays("", dir)
+	if err != nil {
+		t.Fatalf("failed to load data: %v", err)
+	}
+	cal := New(d, models.StyleRevised)
+
+	// Wednesday Dec 2, 2026 in the Nativity Fast: the feast relaxes oil and wine to fish
+	info := cal.GetDayInfo(models.NewDate(2026, 12, 2))
+	if len(info.Feasts) != 1 || info.Feasts[0].Name != "Patronal Feast" {
+		t.Fatalf("expected the patronal feast, got %+v", info.Feasts)
+	}
+	if info.FastingLevel != models.FastingFish {
+		t.Errorf("expected fish, got %s", info.FastingLevel)
+	}
+	found := false
+	for _, r := range info.Readings {
+		if r.Source == "Feast" && r.Gospel != nil && r.Gospel.Passage == "6:17-23" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected the feast's readings, got %+v", info.Readings)
+	}
+}
+
+func TestUserOverlay_Errors(t *testing.T) {
+	tests := []struct {
+		file, content, want string
+	}{
+		{"saints.json", `{"add": [{"name": "A", "month": 1, "day": 1}, {"name": "B", "month": 13, "day": 1}]}`, "saints.json: add[1].month: must be 1-12"},
+		{"saints.json", `{"add": [{"name": "A", "month": "ten", "day": 1}]}`, "saints.json: add[0].month: expected int, got string"},
+		{"saints.json", "{\n  \"add\": [\n}", "saints.json: line 3, column 1"},
+		{"saints.json", `{"add": [{"name": "A", "month": 1, "day": 1, "feast": true}]}`, `add[0]: json: unknown field "feast"`},
+		{"fixed_feasts.json", `{"replace": [{"name": "Nowhere", "rank": "minor", "month": 1, "day": 1}]}`, `replace[0]: no entry "Nowhere" to replace`},
+		{"fixed_feasts.json", `{"add": [{"name": "Local", "rank": "middling", "month": 1, "day": 1}]}`, "add[0].rank: must be great, major or minor"},
+		{"fasting_rules.json", `{"add": [{"name": "Local", "level": "fish", "priority": 5}]}`, "add[0]: needs pascha_offset_start"},
+		{"feast_readings.json", `{"fixed": {"add": {"1/6": {"rank": "great"}}}}`, `fixed.add."1/6": entry "1/6" already exists`},
+	}
+
+	for _, tt := range tests {
+		dir := writeOverlays(t, map[string]string{tt.file: tt.content})
+		_, err := data.LoadWithOverlays("", dir)
+		if err == nil {
+			t.Errorf("%s %s: expected an error", tt.file, tt.content)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.want) || !strings.Contains(err.Error(), dir) {
+			t.Errorf("%s: error %q, want it to cite %q in %s", tt.file, err, tt.want, dir)
+		}
+	}
+}
+
+func TestUserOverlay_MissingDir(t *testing.T) {
+	if _, err := data.LoadWithOverlays("", filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected an error for a missing data directory")
+	}
+}
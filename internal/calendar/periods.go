@@ -0,0 +1,46 @@
+package calendar
+
+import "greekOrtho/internal/models"
+
+// findFeastPeriods returns the forefeasts, afterfeasts and apodoses that fall on date.
+func (c *Calendar) findFeastPeriods(date models.Date, ly *liturgicalYear) []models.FeastPeriod {
+	var result []models.FeastPeriod
+
+	for _, f := range c.fixedPeriodFeasts {
+		// Periods can cross the new year (Nativity, Theophany)
+		for _, y := range []int{date.Year - 1, date.Year, date.Year + 1} {
+			feastDate := civilDate(y, *f.Month, *f.Day, c.style)
+			if p, ok := feastPeriod(f, date.Sub(feastDate)); ok {
+				result = append(result, p)
+			}
+		}
+	}
+
+	daysFromPascha := date.Sub(ly.pascha)
+	for _, f := range c.moveablePeriodFeasts {
+		if p, ok := feastPeriod(f, daysFromPascha-*f.PaschaOffset); ok {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
+
+// feastPeriod returns the period of f on the day offset days after the feast, if any.
+// The afterfeast counts the feast itself as its first day and ends with the apodosis.
+func feastPeriod(f models.Feast, offset int) (models.FeastPeriod, bool) {
+	name := f.PeriodName
+	if name == "" {
+		name = f.Name
+	}
+
+	switch {
+	case offset < 0 && -offset <= f.Forefeast:
+		return models.FeastPeriod{Kind: models.PeriodForefeast, Feast: name, Day: f.Forefeast + offset + 1, Of: f.Forefeast}, true
+	case offset > 0 && offset < f.Afterfeast:
+		return models.FeastPeriod{Kind: models.PeriodAfterfeast, Feast: name, Day: offset + 1, Of: f.Afterfeast + 1}, true
+	case offset > 0 && offset == f.Afterfeast:
+		return models.FeastPeriod{Kind: models.PeriodApodosis, Feast: name, Day: offset + 1, Of: f.Afterfeast + 1}, true
+	}
+	return models.FeastPeriod{}, false
+}
@@ -0,0 +1,68 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"strings"
+)
+
+// ResolvePersonalFast adjusts a day's canonical fasting level by a person's
+// fasting profile: first by their practice, then by any dispensation in force
+// on the day. The reason names each adjustment that changed the foods.
+func ResolvePersonalFast(date models.Date, canonical models.FastingLevel, p models.FastingProfile) models.PersonalFast {
+	foods, _ := canonical.Permits()
+	all := models.NewFoodSet(models.Foods...)
+	var reasons []string
+	adjust := func(label string, next models.FoodSet) {
+		if next != foods {
+			reasons = append(reasons, label+": "+foodChange(foods, next))
+			foods = next
+		}
+	}
+
+	switch p.Practice {
+	case models.PracticeMonastic:
+		adjust("monastic profile", foods&^models.NewFoodSet(models.FoodMeat))
+	case models.PracticeLenient:
+		if foods != all {
+			oilWine, _ := models.FastingOilWine.Permits()
+			adjust("lenient profile", foods|oilWine)
+		}
+	case models.PracticeCustom:
+		adjust("custom profile", (foods|models.NewFoodSet(p.Permit...))&^models.NewFoodSet(p.Forbid...))
+	}
+
+	for _, d := range p.Dispensations {
+		if date.Before(d.From) || date.After(d.To) {
+			continue
+		}
+		extra := models.NewFoodSet(d.Permit...)
+		if level, ok := d.Level.Permits(); ok {
+			extra |= level
+		}
+		adjust(d.Reason+" (until "+d.To.Format("Jan 2")+")", foods|extra)
+	}
+
+	level, _ := models.LevelFor(foods)
+	return models.PersonalFast{Foods: foods, Level: level, Reason: strings.Join(reasons, "; ")}
+}
+
+// foodChange describes how after differs from before, e.g. "also oil, wine"
+// or "no meat".
+func foodChange(before, after models.FoodSet) string {
+	var parts []string
+	if added := after &^ before; added != 0 {
+		parts = append(parts, "also "+foodList(added))
+	}
+	if removed := before &^ after; removed != 0 {
+		parts = append(parts, "no "+foodList(removed))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func foodList(foods models.FoodSet) string {
+	var names []string
+	for _, f := range foods.Foods() {
+		names = append(names, f.Name())
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,132 @@
+package calendar
+
+import (
+	"greekOrtho/internal/data"
+	"greekOrtho/internal/models"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResolvePersonalFast(t *testing.T) {
+	nursing := models.Dispensation{
+		From:   models.NewDate(2026, 3, 1),
+		To:     models.NewDate(2026, 3, 31),
+		Permit: []models.Food{models.FoodDairy, models.FoodEggs},
+		Reason: "Nursing",
+	}
+	tests := []struct {
+		name      string
+		date      models.Date
+		canonical models.FastingLevel
+		profile   models.FastingProfile
+		want      models.FastingLevel // "" for a set no level names
+		changed   bool
+	}{
+		{"lay keeps the canonical fast", models.NewDate(2026, 3, 2), models.FastingStrict,
+			models.FastingProfile{Practice: models.PracticeLay}, models.FastingStrict, false},
+		{"monastic on a fast-free day", models.NewDate(2026, 4, 13), models.FastingNone,
+			models.FastingProfile{Practice: models.PracticeMonastic}, models.FastingDairyFish, true},
+		{"monastic on a fast day", models.NewDate(2026, 3, 2), models.FastingStrict,
+			models.FastingProfile{Practice: models.PracticeMonastic}, models.FastingStrict, false},
+		{"lenient on a strict day", models.NewDate(2026, 3, 2), models.FastingStrict,
+			models.FastingProfile{Practice: models.PracticeLenient}, models.FastingOilWine, true},
+		{"lenient on a fish day", models.NewDate(2026, 12, 1), models.FastingFish,
+			models.FastingProfile{Practice: models.PracticeLenient}, models.FastingFish, false},
+		{"lenient off a fast", models.NewDate(2026, 7, 6), models.FastingNone,
+			models.FastingProfile{Practice: models.PracticeLenient}, models.FastingNone, false},
+		{"custom", models.NewDate(2026, 7, 1), models.FastingOilWine,
+			models.FastingProfile{Practice: models.PracticeCustom, Permit: []models.Food{models.FoodFish}}, models.FastingFish, true},
+		{"dispensation in force", models.NewDate(2026, 3, 10), models.FastingStrict,
+			models.FastingProfile{Practice: models.PracticeLay, Dispensations: []models.Dispensation{nursing}}, "", true},
+		{"dispensation expired", models.NewDate(2026, 4, 1), models.FastingStrict,
+			models.FastingProfile{Practice: models.PracticeLay, Dispensations: []models.Dispensation{nursing}}, models.FastingStrict, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pf := ResolvePersonalFast(tt.date, tt.canonical, tt.profile)
+			if pf.Level != tt.want {
+				t.Errorf("level = %q, want %q", pf.Level, tt.want)
+			}
+			if (pf.Reason != "") != tt.changed {
+				t.Errorf("reason = %q, want changed = %v", pf.Reason, tt.changed)
+			}
+		})
+	}
+}
+
+func TestResolvePersonalFast_Dispensation(t *testing.T) {
+	p := models.FastingProfile{
+		Practice: models.PracticeLay,
+		Dispensations: []models.Dispensation{{
+			From: models.NewDate(2026, 3, 1), To: models.NewDate(2026, 3, 31),
+			Level: models.FastingFish, Reason: "Under medical care",
+		}},
+	}
+	pf := ResolvePersonalFast(models.NewDate(2026, 3, 10), models.FastingStrict, p)
+	if pf.Level != models.FastingFish {
+		t.Errorf("level = %q, want fish", pf.Level)
+	}
+	if !strings.Contains(pf.Reason, "Under medical care") {
+		t.Errorf("reason %q should name the dispensation", pf.Reason)
+	}
+}
+
+func TestFastingProfile_DayInfo(t *testing.T) {
+	cal := New(mustLoad(t), models.StyleRevised)
+	if info := cal.GetDayInfo(models.NewDate(2026, 3, 2)); info.PersonalFast != nil {
+		t.Error("expected no personal fast without a profile")
+	}
+
+	cal.SetFastingProfile(&models.FastingProfile{Practice: models.PracticeLenient})
+	info := cal.GetDayInfo(models.NewDate(2026, 3, 2))
+	if info.FastingLevel != models.FastingStrict {
+		t.Errorf("canonical fast = %s, want strict", info.FastingLevel)
+	}
+	if info.PersonalFast == nil || info.PersonalFast.Level != models.FastingOilWine {
+		t.Errorf("personal fast = %+v, want oil_wine", info.PersonalFast)
+	}
+}
+
+func TestLoadFastingProfile(t *testing.T) {
+	dir := writeOverlays(t, map[string]string{
+		"ok.json": `{"profile": "custom", "forbid": ["meat"],
+			"dispensations": [{"from": "2026-03-01", "to": "2026-03-31", "level": "fish", "reason": "Illness"}]}`,
+		"default.json":    `{}`,
+		"practice.json":   `{"profile": "hermit"}`,
+		"permit.json":     `{"profile": "lay", "permit": ["fish"]}`,
+		"food.json":       `{"profile": "custom", "permit": ["cake"]}`,
+		"range.json":      `{"dispensations": [{"from": "2026-03-31", "to": "2026-03-01", "level": "fish", "reason": "x"}]}`,
+		"reason.json":     `{"dispensations": [{"from": "2026-03-01", "to": "2026-03-31", "level": "fish"}]}`,
+		"date.json":       `{"dispensations": [{"from": "March 1", "to": "2026-03-31", "level": "fish", "reason": "x"}]}`,
+		"unknown.json":    `{"profile": "lay", "strictness": 3}`,
+		"empty_disp.json": `{"dispensations": [{"from": "2026-03-01", "to": "2026-03-31", "reason": "x"}]}`,
+	})
+
+	p, err := data.LoadFastingProfile(filepath.Join(dir, "ok.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Practice != models.PracticeCustom || len(p.Dispensations) != 1 || p.Dispensations[0].To != models.NewDate(2026, 3, 31) {
+		t.Errorf("profile = %+v", p)
+	}
+	if p, err := data.LoadFastingProfile(filepath.Join(dir, "default.json")); err != nil || p.Practice != models.PracticeLay {
+		t.Errorf("empty profile = %+v, %v; want lay", p, err)
+	}
+
+	for name, want := range map[string]string{
+		"practice.json":   "profile: must be monastic, lay, lenient or custom",
+		"permit.json":     "need the custom profile",
+		"food.json":       `permit[0]: unknown food "cake"`,
+		"range.json":      "dispensations[0].to: 2026-03-01 is before from 2026-03-31",
+		"reason.json":     "dispensations[0].reason: is required",
+		"date.json":       `invalid date "March 1"`,
+		"unknown.json":    "unknown field",
+		"empty_disp.json": "dispensations[0]: needs level or permit",
+	} {
+		_, err := data.LoadFastingProfile(filepath.Join(dir, name))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: error = %v, want %q", name, err, want)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package calendar
+
+import (
+	"greekOrtho/internal/data"
+	"greekOrtho/internal/models"
+	"testing"
+)
+
+func TestProfile_Russian(t *testing.T) {
+	d, err := data.LoadWithOverlays("russian", "")
+	if err != nil {
+		t.Fatalf("failed to load data: %v", err)
+	}
+	cal := New(d, models.StyleRevised)
+
+	// The Protection moves from Oct 28 to Oct 1
+	info := cal.GetDayInfo(models.NewDate(2026, 10, 1))
+	if len(info.Feasts) != 1 || info.Feasts[0].Name != "Protection of the Theotokos" {
+		t.Errorf("Oct 1: expected the Protection, got %+v", info.Feasts)
+	}
+	if info := cal.GetDayInfo(models.NewDate(2026, 10, 28)); len(info.Feasts) != 0 {
+		t.Errorf("Oct 28: expected no feast, got %+v", info.Feasts)
+	}
+
+	// Added saints sit alongside the base calendar's
+	found := false
+	for _, s := range cal.GetDayInfo(models.NewDate(2026, 9, 25)).Saints {
+		if s.Name == "St. Sergius of Radonezh" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Sep 25: expected St. Sergius of Radonezh")
+	}
+
+	// No fish from Dec 20: Tuesday Dec 22, 2026 keeps oil and wine
+	if info := cal.GetDayInfo(models.NewDate(2026, 12, 22)); info.FastingLevel != models.FastingOilWine {
+		t.Errorf("Dec 22: expected oil_wine, got %s", info.FastingLevel)
+	}
+
+	// The replaced Nativity Fast keeps its feast relaxations: St. Nicholas on
+	// Wednesday, Dec 6, 2028 allows fish as a vigil feast
+	e := cal.ExplainFasting(models.NewDate(2028, 12, 6))
+	if e.Level != models.FastingFish {
+		t.Errorf("Dec 6, 2028: expected fish, got %s", e.Level)
+	}
+	if len(e.Relaxations) != 1 || !e.Relaxations[0].Accepted || e.Relaxations[0].Feast != "St. Nicholas the Wonderworker" {
+		t.Errorf("Dec 6, 2028: relaxations = %+v, want one accepted for St. Nicholas", e.Relaxations)
+	}
+}
+
+func TestProfile_Unknown(t *testing.T) {
+	if _, err := data.LoadWithOverlays("atlantean", ""); err == nil {
+		t.Error("expected an error for an unknown profile")
+	}
+}
+
+func TestProfiles_ApplyCleanly(t *testing.T) {
+	profiles, err := data.Profiles()
+	if err != nil {
+		t.Fatalf("failed to list profiles: %v", err)
+	}
+	if len(profiles) == 0 {
+		t.Fatal("expected at least one profile")
+	}
+	for _, p := range profiles {
+		changes, err := data.ProfileChanges(p.Name)
+		if err != nil {
+			t.Errorf("%s: %v", p.Name, err)
+		}
+		if len(changes) == 0 {
+			t.Errorf("%s: expected changes", p.Name)
+		}
+	}
+}
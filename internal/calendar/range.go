@@ -0,0 +1,41 @@
+package calendar
+
+import "greekOrtho/internal/models"
+
+// Range returns the liturgical information for every day from start to end
+// inclusive. Pascha is computed once per year and feasts, saints and readings
+// come from the calendar's indexes, so long ranges (e.g. multi-year exports)
+// are cheap. It returns nil if end is before start.
+func (c *Calendar) Range(start, end models.Date) []models.DayInfo {
+	if end.Before(start) {
+		return nil
+	}
+
+	days := make([]models.DayInfo, 0, end.Sub(start)+1)
+	for d := start; !d.After(end); d = d.AddDays(1) {
+		days = append(days, c.GetDayInfo(d))
+	}
+	return days
+}
+
+// Month returns the liturgical information for every day of the month containing date.
+func (c *Calendar) Month(date models.Date) []models.DayInfo {
+	first := models.NewDate(date.Year, date.Month, 1)
+	return c.Range(first, models.NewDate(date.Year, date.Month+1, 0))
+}
+
+// FastingRange returns the canonical fasting level of every day from start to
+// end inclusive. It resolves only the fasting, so it is much cheaper than
+// Range for statistics over long spans. It returns nil if end is before start.
+func (c *Calendar) FastingRange(start, end models.Date) []models.FastingDay {
+	if end.Before(start) {
+		return nil
+	}
+
+	days := make([]models.FastingDay, 0, end.Sub(start)+1)
+	for d := start; !d.After(end); d = d.AddDays(1) {
+		level, reason := c.fastingOn(d)
+		days = append(days, models.FastingDay{Date: d, Level: level, Reason: reason})
+	}
+	return days
+}
@@ -0,0 +1,69 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestRange_MatchesGetDayInfo(t *testing.T) {
+	cal := newCalendar(t)
+	start := models.NewDate(2025, 12, 20)
+	end := models.NewDate(2027, 1, 10)
+
+	days := cal.Range(start, end)
+	if want := end.Sub(start) + 1; len(days) != want {
+		t.Fatalf("Range returned %d days, want %d", len(days), want)
+	}
+
+	for i, got := range days {
+		date := start.AddDays(i)
+		if got.Date != date {
+			t.Fatalf("day %d: got date %s, want %s", i, got.Date, date)
+		}
+		if want := cal.GetDayInfo(date); !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s: Range and GetDayInfo disagree", date)
+		}
+	}
+}
+
+func TestRange_Empty(t *testing.T) {
+	cal := newCalendar(t)
+	start := models.NewDate(2026, 5, 2)
+	if days := cal.Range(start, start.AddDays(-1)); days != nil {
+		t.Errorf("expected nil for reversed range, got %d days", len(days))
+	}
+}
+
+func TestMonth(t *testing.T) {
+	cal := newCalendar(t)
+	days := cal.Month(models.NewDate(2028, 2, 17))
+	if len(days) != 29 {
+		t.Fatalf("February 2028: got %d days, want 29", len(days))
+	}
+	if days[0].Date.Day != 1 || days[28].Date.Day != 29 {
+		t.Errorf("February 2028: unexpected bounds %s–%s",
+			days[0].Date.Format("Jan 2"), days[28].Date.Format("Jan 2"))
+	}
+}
+
+func TestRange_Concurrent(t *testing.T) {
+	cal := newCalendar(t)
+	start := models.NewDate(2020, 1, 1)
+	want := cal.Range(start, models.NewDate(2023, 1, 1))
+
+	fresh := newCalendar(t)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got := fresh.Range(start, models.NewDate(2023, 1, 1))
+			if !reflect.DeepEqual(got, want) {
+				t.Error("concurrent Range returned different results")
+			}
+		}()
+	}
+	wg.Wait()
+}
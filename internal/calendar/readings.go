@@ -4,58 +4,104 @@ import (
 	"fmt"
 	"greekOrtho/internal/data"
 	"greekOrtho/internal/models"
-	"time"
 )
 
 // ResolveReadings determines the scripture readings for a given date.
-// It checks feast readings first (fixed and moveable), then falls back to the
-// lectionary cycle (epistle cycle + gospel series with Lukan Jump computation).
-func ResolveReadings(date time.Time, pascha time.Time, d *data.CalendarData, feasts []models.Feast) []models.DayReadings {
-	daysFromPascha := int(date.Sub(pascha).Hours() / 24)
+// The day's proper readings from the moveable cycle (Triodion and Pentecostarion)
+// or a weekday-anchored Sunday stand in for the lectionary cycle (epistle cycle +
+// gospel series with Lukan Jump computation); fixed feast readings are then
+// combined with them. Fixed feast readings are looked up on the calendar style's date.
+// It resolves the year's feast transfers itself; the Calendar passes its cached
+// ones to resolveReadings instead.
+func ResolveReadings(date models.Date, pascha models.Date, style models.CalendarStyle, d *data.CalendarData, feasts []models.Feast) []models.DayReadings {
+	transfers, _ := resolveTransfers(pascha, style, d.TransferRules)
+	return resolveReadings(date, pascha, style, d, feasts, transfers)
+}
+
+// resolveReadings is ResolveReadings with the transfers of the year of pascha.
+func resolveReadings(date models.Date, pascha models.Date, style models.CalendarStyle, d *data.CalendarData, feasts []models.Feast, transfers []transfer) []models.DayReadings {
+	daysFromPascha := date.Sub(pascha)
 
-	// 1. Check feast readings (both fixed and moveable)
-	feastReadings := resolveFeastReadings(date, daysFromPascha, d)
+	// 1. Check fixed feast readings, following any transfers
+	feastReadings := resolveFeastReadings(date, fixedDate(date, style), transfers, d)
 
-	// 2. Resolve cycle readings
-	cycleReadings := resolveCycleReadings(date, pascha, daysFromPascha, d)
+	// 2. Resolve the day's proper readings, falling back to the cycle
+	cycleReadings := resolveProperReadings(daysFromPascha, feasts, d)
+	if cycleReadings == nil {
+		cycleReadings = resolveCycleReadings(date, pascha, daysFromPascha, style, d)
+	}
 
 	// 3. Combine: great feasts replace, minor/major supplement
 	return combineReadings(cycleReadings, feastReadings, feasts)
 }
 
-// resolveFeastReadings checks fixed (month/day) and moveable (pascha-offset) feast readings.
-func resolveFeastReadings(date time.Time, daysFromPascha int, d *data.CalendarData) *models.DayReadings {
+// resolveFeastReadings checks fixed (month/day) feast readings. They are looked up on
+// fixed, the date in the calendar style, unless the feast was transferred away; a feast
+// transferred to this date brings its readings with it.
+func resolveFeastReadings(date models.Date, fixed models.Date, transfers []transfer, d *data.CalendarData) *models.DayReadings {
 	// Check fixed feasts
-	key := fmt.Sprintf("%d/%d", date.Month(), date.Day())
-	if entry, ok := d.FeastReadings.Fixed[key]; ok {
-		reading := models.DayReadings{
-			Epistle: entry.Epistle,
-			Gospel:  entry.Gospel,
-			Source:  "Feast",
+	if !transferredAway(transfers, date) {
+		key := fmt.Sprintf("%d/%d", fixed.Month, fixed.Day)
+		if entry, ok := d.FeastReadings.Fixed[key]; ok {
+			reading := models.DayReadings{
+				Epistle: entry.Epistle,
+				Gospel:  entry.Gospel,
+				Source:  "Feast",
+			}
+			return &reading
+		}
+	}
+
+	// Check feasts transferred to this date
+	for _, t := range transfers {
+		if t.to != date {
+			continue
+		}
+		if entry, ok := d.FeastReadings.Fixed[fmt.Sprintf("%d/%d", t.rule.Month, t.rule.Day)]; ok {
+			reading := models.DayReadings{
+				Epistle: entry.Epistle,
+				Gospel:  entry.Gospel,
+				Source:  "Feast",
+			}
+			return &reading
 		}
-		return &reading
 	}
 
-	// Check moveable feasts
-	offsetKey := fmt.Sprintf("%d", daysFromPascha)
-	if entry, ok := d.FeastReadings.Moveable[offsetKey]; ok {
-		reading := models.DayReadings{
+	return nil
+}
+
+// resolveProperReadings returns the readings of the moveable (pascha-offset) day, or
+// else of the first weekday-anchored feast among feasts that has any.
+func resolveProperReadings(daysFromPascha int, feasts []models.Feast, d *data.CalendarData) *models.DayReadings {
+	if entry, ok := d.FeastReadings.Moveable[fmt.Sprintf("%d", daysFromPascha)]; ok {
+		return &models.DayReadings{
 			Epistle: entry.Epistle,
 			Gospel:  entry.Gospel,
 			Source:  "Feast",
 		}
-		return &reading
 	}
 
+	for _, f := range feasts {
+		if f.Anchor == nil {
+			continue
+		}
+		if entry, ok := d.FeastReadings.Anchored[f.Name]; ok {
+			return &models.DayReadings{
+				Epistle: entry.Epistle,
+				Gospel:  entry.Gospel,
+				Source:  "Feast",
+			}
+		}
+	}
 	return nil
 }
 
 // resolveCycleReadings looks up the epistle and gospel from the lectionary cycle tables.
-func resolveCycleReadings(date time.Time, pascha time.Time, daysFromPascha int, d *data.CalendarData) *models.DayReadings {
+func resolveCycleReadings(date models.Date, pascha models.Date, daysFromPascha int, style models.CalendarStyle, d *data.CalendarData) *models.DayReadings {
 	weekday := fmt.Sprintf("%d", date.Weekday())
 
 	epistle := resolveEpistle(daysFromPascha, weekday, d)
-	gospel := resolveGospel(date, pascha, daysFromPascha, weekday, d)
+	gospel := resolveGospel(date, pascha, daysFromPascha, weekday, style, d)
 
 	if epistle == nil && gospel == nil {
 		return nil
@@ -71,7 +117,7 @@ func resolveCycleReadings(date time.Time, pascha time.Time, daysFromPascha int,
 // resolveEpistle looks up the epistle reading from the cycle table.
 func resolveEpistle(daysFromPascha int, weekday string, d *data.CalendarData) *models.ScriptureReading {
 	if daysFromPascha < 0 {
-		lentStart := -48   // Clean Monday
+		lentStart := -48     // Clean Monday
 		triodionStart := -70 // Sunday of Publican and Pharisee
 
 		if daysFromPascha >= lentStart && daysFromPascha < -7 {
@@ -111,7 +157,7 @@ func resolveEpistle(daysFromPascha int, weekday string, d *data.CalendarData) *m
 }
 
 // resolveGospel determines which gospel series applies and looks up the reading.
-func resolveGospel(date time.Time, pascha time.Time, daysFromPascha int, weekday string, d *data.CalendarData) *models.ScriptureReading {
+func resolveGospel(date models.Date, pascha models.Date, daysFromPascha int, weekday string, style models.CalendarStyle, d *data.CalendarData) *models.ScriptureReading {
 	// Before Pascha: Lenten period
 	if daysFromPascha < 0 {
 		return resolveLentenGospel(daysFromPascha, weekday, d)
@@ -132,45 +178,13 @@ func resolveGospel(date time.Time, pascha time.Time, daysFromPascha int, weekday
 	}
 
 	// After Pentecost: Matthew → Luke series with Lukan Jump
-	elevation := time.Date(date.Year(), 9, 14, 0, 0, 0, 0, time.UTC)
-	pentecostDate := pascha.AddDate(0, 0, pentecost)
-
-	// Weeks from Pentecost to Elevation of the Cross
-	daysToElevation := int(elevation.Sub(pentecostDate).Hours() / 24)
-	matthewWeeks := daysToElevation / 7
-	if matthewWeeks < 1 {
-		matthewWeeks = 1
-	}
-
-	// Days since Pentecost (day after Pentecost = day 1 of Matthew series)
-	daysSincePentecost := daysFromPascha - pentecost
-	matthewWeek := (daysSincePentecost / 7) + 1
-
-	// Lukan Jump: if Matthew series is shorter than 16 weeks, Luke starts earlier
-	lukanJump := 0
-	if matthewWeeks < 17 {
-		lukanJump = 17 - matthewWeeks
-	}
-
-	// Are we still in the Matthew series?
-	if matthewWeek <= matthewWeeks || matthewWeek <= 16 {
-		weekKey := fmt.Sprintf("%d", matthewWeek)
-		if weekData, ok := d.GospelCycle.Matthew[weekKey]; ok {
-			if reading, ok := weekData[weekday]; ok {
-				return &reading
-			}
-		}
-		return nil
-	}
-
-	// Luke series
-	lukeWeek := matthewWeek - matthewWeeks + lukanJump
-	if lukeWeek < 1 {
-		lukeWeek = 1
+	series, week := gospelWeek(date, pascha, style)
+	weekKey := fmt.Sprintf("%d", week)
+	table := d.GospelCycle.Matthew
+	if series == seriesLuke {
+		table = d.GospelCycle.Luke
 	}
-
-	weekKey := fmt.Sprintf("%d", lukeWeek)
-	if weekData, ok := d.GospelCycle.Luke[weekKey]; ok {
+	if weekData, ok := table[weekKey]; ok {
 		if reading, ok := weekData[weekday]; ok {
 			return &reading
 		}
@@ -179,10 +193,41 @@ func resolveGospel(date time.Time, pascha time.Time, daysFromPascha int, weekday
 	return nil
 }
 
+// Gospel series read after Pentecost.
+const (
+	seriesMatthew = "Matthew"
+	seriesLuke    = "Luke"
+)
+
+// matthewWeeks is the number of weeks of Matthew in the gospel cycle.
+const matthewWeeks = 16
+
+// gospelWeek returns the gospel series and its week for a day after Pentecost in
+// the liturgical year of pascha. Weeks run from Monday to Sunday, so the Nth
+// Sunday of a series (All Saints is the 1st of Matthew) closes its Nth week.
+//
+// Lukan Jump: Luke begins on the Monday after the Sunday after the Elevation of
+// the Cross, however many weeks of Matthew have been read by then. In years of
+// an early Pascha Matthew runs out first, and Luke begins when it does.
+func gospelWeek(date models.Date, pascha models.Date, style models.CalendarStyle) (string, int) {
+	pentecostDate := pascha.AddDays(49)
+	afterElevation := models.FeastAnchor{Month: 9, Day: 14, Weekday: 0, Relation: models.AnchorAfter}
+	lukeStart := anchoredDate(afterElevation, pascha.Year, style).AddDays(1)
+	if end := pentecostDate.AddDays(matthewWeeks*7 + 1); end.Before(lukeStart) {
+		lukeStart = end
+	}
+
+	if date.Before(lukeStart) {
+		// Days since Pentecost (Monday after Pentecost = day 1 of week 1 of Matthew)
+		return seriesMatthew, (date.Sub(pentecostDate)-1)/7 + 1
+	}
+	return seriesLuke, date.Sub(lukeStart)/7 + 1
+}
+
 // resolveLentenGospel handles the Lenten and pre-Lenten period gospel readings.
 func resolveLentenGospel(daysFromPascha int, weekday string, d *data.CalendarData) *models.ScriptureReading {
-	lentStart := -48      // Clean Monday
-	triodionStart := -70  // Sunday of Publican and Pharisee
+	lentStart := -48     // Clean Monday
+	triodionStart := -70 // Sunday of Publican and Pharisee
 	holyWeekStart := -7
 
 	wd := int(0)
@@ -247,17 +292,18 @@ func combineReadings(cycle *models.DayReadings, feast *models.DayReadings, feast
 		return []models.DayReadings{*feast}
 	}
 
-	// Check if any feast is a great feast — great feasts replace cycle readings
-	hasGreatFeast := false
+	// Great feasts replace the other readings: a moveable feast's (which stand in
+	// for the cycle) replace any fixed feast's, whatever the order of feasts, and
+	// a fixed feast's replace the cycle
 	for _, f := range feasts {
-		if f.Rank == models.RankGreat {
-			hasGreatFeast = true
-			break
+		if f.Rank == models.RankGreat && f.PaschaOffset != nil {
+			return []models.DayReadings{*cycle}
 		}
 	}
-
-	if hasGreatFeast {
-		return []models.DayReadings{*feast}
+	for _, f := range feasts {
+		if f.Rank == models.RankGreat {
+			return []models.DayReadings{*feast}
+		}
 	}
 
 	// Minor/major feasts: show both cycle and feast readings
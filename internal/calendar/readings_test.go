@@ -4,7 +4,6 @@ import (
 	"greekOrtho/internal/data"
 	"greekOrtho/internal/models"
 	"testing"
-	"time"
 )
 
 func TestResolveReadings_Pascha(t *testing.T) {
@@ -14,11 +13,11 @@ func TestResolveReadings_Pascha(t *testing.T) {
 	}
 
 	// Pascha 2026 is April 12
-	pascha := time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC)
+	pascha := models.NewDate(2026, 4, 12)
 	date := pascha
 
 	feasts := []models.Feast{{Name: "Pascha", Rank: models.RankGreat}}
-	readings := ResolveReadings(date, pascha, d, feasts)
+	readings := ResolveReadings(date, pascha, models.StyleRevised, d, feasts)
 
 	if len(readings) == 0 {
 		t.Fatal("expected readings for Pascha, got none")
@@ -40,11 +39,11 @@ func TestResolveReadings_Pentecost(t *testing.T) {
 	}
 
 	// Pascha 2026 is April 12, Pentecost is 49 days later = May 31
-	pascha := time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC)
-	date := time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC)
+	pascha := models.NewDate(2026, 4, 12)
+	date := models.NewDate(2026, 5, 31)
 
 	feasts := []models.Feast{{Name: "Pentecost", Rank: models.RankGreat}}
-	readings := ResolveReadings(date, pascha, d, feasts)
+	readings := ResolveReadings(date, pascha, models.StyleRevised, d, feasts)
 
 	if len(readings) == 0 {
 		t.Fatal("expected readings for Pentecost, got none")
@@ -66,11 +65,11 @@ func TestResolveReadings_FixedFeast(t *testing.T) {
 	}
 
 	// Theophany - January 6
-	pascha := time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC)
-	date := time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
+	pascha := models.NewDate(2026, 4, 12)
+	date := models.NewDate(2026, 1, 6)
 
 	feasts := []models.Feast{{Name: "Theophany", Rank: models.RankGreat}}
-	readings := ResolveReadings(date, pascha, d, feasts)
+	readings := ResolveReadings(date, pascha, models.StyleRevised, d, feasts)
 
 	if len(readings) == 0 {
 		t.Fatal("expected readings for Theophany, got none")
@@ -92,11 +91,11 @@ func TestResolveReadings_ElevationOfCross(t *testing.T) {
 	}
 
 	// Elevation of the Cross - September 14
-	pascha := time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC)
-	date := time.Date(2026, 9, 14, 0, 0, 0, 0, time.UTC)
+	pascha := models.NewDate(2026, 4, 12)
+	date := models.NewDate(2026, 9, 14)
 
 	feasts := []models.Feast{{Name: "Elevation of the Holy Cross", Rank: models.RankGreat}}
-	readings := ResolveReadings(date, pascha, d, feasts)
+	readings := ResolveReadings(date, pascha, models.StyleRevised, d, feasts)
 
 	if len(readings) == 0 {
 		t.Fatal("expected readings for Elevation, got none")
@@ -117,11 +116,12 @@ func TestResolveReadings_RegularSunday(t *testing.T) {
 		t.Fatalf("failed to load data: %v", err)
 	}
 
-	// A regular Sunday after Pentecost (June 7, 2026 = 1 week after Pentecost)
-	pascha := time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC)
-	date := time.Date(2026, 6, 7, 0, 0, 0, 0, time.UTC)
+	// A regular Sunday after Pentecost (June 14, 2026 = 2 weeks after Pentecost;
+	// June 7 is All Saints, whose proper readings replace the cycle)
+	pascha := models.NewDate(2026, 4, 12)
+	date := models.NewDate(2026, 6, 14)
 
-	readings := ResolveReadings(date, pascha, d, nil)
+	readings := ResolveReadings(date, pascha, models.StyleRevised, d, nil)
 
 	if len(readings) == 0 {
 		t.Fatal("expected cycle readings for regular Sunday, got none")
@@ -139,6 +139,36 @@ func TestResolveReadings_RegularSunday(t *testing.T) {
 	}
 }
 
+func TestResolveReadings_EarlyPaschaSeptember(t *testing.T) {
+	d, err := data.Load()
+	if err != nil {
+		t.Fatalf("failed to load data: %v", err)
+	}
+
+	// Pascha 2010 is April 4, so the 16 weeks of Matthew end on Sep 12, a week
+	// before the Lukan Jump; Luke begins on Monday, Sep 13
+	pascha := models.NewDate(2010, 4, 4)
+	tests := []struct {
+		date    models.Date
+		passage string
+	}{
+		{models.NewDate(2010, 9, 13), "14:12-15"},
+		{models.NewDate(2010, 9, 16), "16:1-9"},
+		{models.NewDate(2010, 9, 20), "17:20-25"},
+	}
+
+	for _, tt := range tests {
+		readings := ResolveReadings(tt.date, pascha, models.StyleRevised, d, nil)
+		if len(readings) == 0 || readings[0].Gospel == nil {
+			t.Errorf("%s: expected a Gospel reading, got %+v", tt.date, readings)
+			continue
+		}
+		if g := readings[0].Gospel; g.Book != "Luke" || g.Passage != tt.passage {
+			t.Errorf("%s: expected Luke %s, got %s %s", tt.date, tt.passage, g.Book, g.Passage)
+		}
+	}
+}
+
 func TestResolveReadings_Annunciation(t *testing.T) {
 	d, err := data.Load()
 	if err != nil {
@@ -146,11 +176,11 @@ func TestResolveReadings_Annunciation(t *testing.T) {
 	}
 
 	// Annunciation - March 25
-	pascha := time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC)
-	date := time.Date(2026, 3, 25, 0, 0, 0, 0, time.UTC)
+	pascha := models.NewDate(2026, 4, 12)
+	date := models.NewDate(2026, 3, 25)
 
 	feasts := []models.Feast{{Name: "Annunciation of the Theotokos", Rank: models.RankGreat}}
-	readings := ResolveReadings(date, pascha, d, feasts)
+	readings := ResolveReadings(date, pascha, models.StyleRevised, d, feasts)
 
 	if len(readings) == 0 {
 		t.Fatal("expected readings for Annunciation, got none")
@@ -164,3 +194,62 @@ func TestResolveReadings_Annunciation(t *testing.T) {
 		t.Errorf("expected Luke gospel for Annunciation, got %s", r.Gospel.Book)
 	}
 }
+
+func TestResolveReadings_AnchoredSunday(t *testing.T) {
+	cal := newCalendar(t)
+
+	// The Forefathers' readings replace the cycle's at the Liturgy; the
+	// Eothinon is read at Matins
+	info := cal.GetDayInfo(models.NewDate(2026, 12, 13))
+	if len(info.Readings) != 2 {
+		t.Fatalf("expected 2 readings, got %d", len(info.Readings))
+	}
+	if r := info.Readings[0]; r.Source != "Feast" || r.Gospel == nil || r.Gospel.Book != "Luke" || r.Gospel.Passage != "14:16-24" {
+		t.Errorf("expected the Feast's Luke 14:16-24, got %s %+v", r.Source, r.Gospel)
+	}
+	if r := info.Readings[1]; r.Source != "Matins" || r.Gospel == nil || r.Gospel.Book != "Luke" || r.Gospel.Passage != "24:36-53" {
+		t.Errorf("expected the Matins Luke 24:36-53, got %s %+v", r.Source, r.Gospel)
+	}
+
+	// On Jan 1, 2023 the Sunday after the Nativity yields to the Circumcision
+	info = cal.GetDayInfo(models.NewDate(2023, 1, 1))
+	if len(info.Readings) != 2 {
+		t.Fatalf("expected 2 readings, got %d", len(info.Readings))
+	}
+	if r := info.Readings[0]; r.Source != "Feast" || r.Gospel == nil || r.Gospel.Book != "Luke" || r.Gospel.Passage != "2:20-21,40-52" {
+		t.Errorf("expected the Feast's Luke 2:20-21,40-52, got %s %+v", r.Source, r.Gospel)
+	}
+	if r := info.Readings[1]; r.Source != "Matins" || r.Gospel == nil || r.Gospel.Book != "John" || r.Gospel.Passage != "20:1-10" {
+		t.Errorf("expected the Matins John 20:1-10, got %s %+v", r.Source, r.Gospel)
+	}
+}
+
+func TestResolveReadings_MoveableGreatFeastOverFixed(t *testing.T) {
+	// On the Old Calendar the Annunciation (Mar 25 O.S.) fell on Pascha in
+	// 1991 and on Palm Sunday in 1901; the fixed feast is listed first, but
+	// the moveable feast's readings are read.
+	cal := New(mustLoad(t), models.StyleJulian)
+	tests := []struct {
+		name  string
+		date  models.Date
+		feast string
+		want  models.ScriptureReading
+	}{
+		{"Kyriopascha", models.NewDate(1991, 4, 7), "Pascha (Resurrection of Christ)", models.ScriptureReading{Book: "John", Passage: "1:1-17"}},
+		{"Annunciation on Palm Sunday", models.NewDate(1901, 4, 7), "Palm Sunday (Entry into Jerusalem)", models.ScriptureReading{Book: "John", Passage: "12:1-18"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := cal.GetDayInfo(tt.date)
+			if len(info.Feasts) < 2 || info.Feasts[0].Name != "Annunciation of the Theotokos" || info.Feasts[1].Name != tt.feast {
+				t.Fatalf("feasts = %+v, want the Annunciation and %s", info.Feasts, tt.feast)
+			}
+			if len(info.Readings) != 1 {
+				t.Fatalf("expected 1 reading, got %+v", info.Readings)
+			}
+			if g := info.Readings[0].Gospel; g == nil || *g != tt.want {
+				t.Errorf("gospel = %+v, want %s %s", g, tt.want.Book, tt.want.Passage)
+			}
+		})
+	}
+}
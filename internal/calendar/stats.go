@@ -0,0 +1,66 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"slices"
+)
+
+// FastingStats breaks down the fasting from start to end inclusive: days per
+// level, per named period and per month, and the runs of strict days.
+func (c *Calendar) FastingStats(start, end models.Date) models.FastingStats {
+	var periods []models.FastingPeriod
+	for y := start.Year - 1; y <= end.Year; y++ {
+		periods = append(periods, c.FastingPeriods(y)...)
+	}
+	return SummarizeFasting(c.FastingRange(start, end), periods)
+}
+
+// SummarizeFasting computes the statistics of consecutive days, counting the
+// days of each of periods that fall among them.
+func SummarizeFasting(days []models.FastingDay, periods []models.FastingPeriod) models.FastingStats {
+	var s models.FastingStats
+	if len(days) == 0 {
+		return s
+	}
+	s.Start, s.End = days[0].Date, days[len(days)-1].Date
+
+	var stretch *models.FastingStretch
+	for _, d := range days {
+		s.Add(d.Level)
+
+		if n := len(s.Months); n == 0 || s.Months[n-1].Month != d.Date.Month || s.Months[n-1].Year != d.Date.Year {
+			s.Months = append(s.Months, models.MonthStats{Year: d.Date.Year, Month: d.Date.Month})
+		}
+		s.Months[len(s.Months)-1].Add(d.Level)
+
+		if d.Level == models.FastingStrict || d.Level.StricterThan(models.FastingStrict) {
+			if stretch == nil {
+				stretch = &models.FastingStretch{Start: d.Date}
+			}
+			stretch.End = d.Date
+		} else if stretch != nil {
+			s.Stretches = append(s.Stretches, *stretch)
+			stretch = nil
+		}
+	}
+	if stretch != nil {
+		s.Stretches = append(s.Stretches, *stretch)
+	}
+	slices.SortStableFunc(s.Stretches, func(a, b models.FastingStretch) int {
+		return b.Days() - a.Days()
+	})
+
+	for _, p := range periods {
+		first, last := p.Start, p.End
+		if first.Before(s.Start) {
+			first = s.Start
+		}
+		if last.After(s.End) {
+			last = s.End
+		}
+		if n := last.Sub(first) + 1; n > 0 {
+			s.Periods = append(s.Periods, models.PeriodStats{Period: p, Days: n})
+		}
+	}
+	return s
+}
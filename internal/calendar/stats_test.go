@@ -0,0 +1,84 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"testing"
+)
+
+func TestSummarizeFasting(t *testing.T) {
+	levels := []models.FastingLevel{
+		models.FastingNone, models.FastingStrict, models.FastingStrict, models.FastingOilWine, // Jan 30 – Feb 2
+		models.FastingTotal, models.FastingXerophagy, models.FastingStrict, models.FastingFish, // Feb 3 – 6
+	}
+	var days []models.FastingDay
+	start := models.NewDate(2026, 1, 30)
+	for i, l := range levels {
+		days = append(days, models.FastingDay{Date: start.AddDays(i), Level: l})
+	}
+	period := models.FastingPeriod{Name: "Test Fast", Level: models.FastingStrict,
+		Start: models.NewDate(2026, 1, 20), End: models.NewDate(2026, 2, 1)}
+
+	s := SummarizeFasting(days, []models.FastingPeriod{period})
+	if s.Days != 8 || s.FastDays != 7 || s.StrictDays != 5 || s.FishDays != 1 || s.WineDays != 2 {
+		t.Errorf("counts = %+v", s.FastingCounts)
+	}
+	if len(s.Stretches) != 2 || s.Stretches[0].Start != models.NewDate(2026, 2, 3) || s.Stretches[0].Days() != 3 ||
+		s.Stretches[1].Days() != 2 {
+		t.Errorf("stretches = %+v, want Feb 3–5 then Jan 31–Feb 1", s.Stretches)
+	}
+	if len(s.Months) != 2 || s.Months[0].Days != 2 || s.Months[1].Days != 6 || s.Months[1].StrictDays != 4 {
+		t.Errorf("months = %+v", s.Months)
+	}
+	if len(s.Periods) != 1 || s.Periods[0].Days != 3 {
+		t.Errorf("periods = %+v, want 3 days of the test fast", s.Periods)
+	}
+	if got := SummarizeFasting(nil, nil); got.Days != 0 {
+		t.Errorf("empty summary = %+v", got)
+	}
+}
+
+func TestFastingStats_Year(t *testing.T) {
+	cal := New(mustLoad(t), models.StyleRevised)
+	s := cal.FastingStats(models.NewDate(2026, 1, 1), models.NewDate(2026, 12, 31))
+	if s.Days != 365 || len(s.Months) != 12 {
+		t.Fatalf("days = %d, months = %d", s.Days, len(s.Months))
+	}
+
+	sum, monthDays := 0, 0
+	for _, n := range s.Levels {
+		sum += n
+	}
+	for _, m := range s.Months {
+		monthDays += m.Days
+	}
+	if sum != s.Days || monthDays != s.Days {
+		t.Errorf("levels sum to %d and months to %d, want %d", sum, monthDays, s.Days)
+	}
+	if s.Levels[models.FastingTotal] != 3 {
+		t.Errorf("total fast days = %d, want 3 (Clean Monday and Tuesday, Holy Friday)", s.Levels[models.FastingTotal])
+	}
+	if len(s.Periods) != 6 || s.Periods[1].Period.Name != "Great Lent" || s.Periods[1].Days != 42 {
+		t.Errorf("periods = %+v", s.Periods)
+	}
+	if len(s.Stretches) == 0 || s.Stretches[0].Start != models.NewDate(2026, 2, 23) || s.Stretches[0].Days() != 5 {
+		t.Errorf("longest strict stretch = %+v, want Clean Monday to Friday", s.Stretches)
+	}
+}
+
+func TestFastingRange(t *testing.T) {
+	cal := New(mustLoad(t), models.StyleJulian)
+	start, end := models.NewDate(2026, 12, 20), models.NewDate(2027, 1, 10)
+	days := cal.FastingRange(start, end)
+	if len(days) != end.Sub(start)+1 {
+		t.Fatalf("got %d days, want %d", len(days), end.Sub(start)+1)
+	}
+	for _, d := range days {
+		info := cal.GetDayInfo(d.Date)
+		if d.Level != info.FastingLevel || d.Reason != info.FastingReason {
+			t.Errorf("%s: %s (%s), GetDayInfo has %s (%s)", d.Date, d.Level, d.Reason, info.FastingLevel, info.FastingReason)
+		}
+	}
+	if cal.FastingRange(end, start) != nil {
+		t.Error("expected nil for an inverted range")
+	}
+}
@@ -0,0 +1,23 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"time"
+)
+
+// fixedDate returns the date against which fixed-date commemorations are matched.
+func fixedDate(date models.Date, style models.CalendarStyle) models.Date {
+	if style == models.StyleJulian {
+		return date.Julian()
+	}
+	return date
+}
+
+// civilDate returns the civil date on which a fixed month/day falls in the given
+// year of the calendar style.
+func civilDate(year, month, day int, style models.CalendarStyle) models.Date {
+	if style == models.StyleJulian {
+		return models.FromJulian(year, time.Month(month), day)
+	}
+	return models.NewDate(year, time.Month(month), day)
+}
@@ -0,0 +1,53 @@
+package calendar
+
+import "greekOrtho/internal/models"
+
+// transfer is a fixed feast moved off its date by a transfer rule.
+type transfer struct {
+	rule *models.TransferRule
+	from models.Date // Civil date the feast would have fallen on
+	to   models.Date // Civil date it is celebrated on
+}
+
+// coincidence is a merge or coincidence rule that applies on a date.
+type coincidence struct {
+	rule *models.TransferRule
+	date models.Date
+}
+
+// resolveTransfers applies the transfer rules to the liturgical year of Pascha p.
+// Fixed dates are placed in the year using the calendar style.
+func resolveTransfers(p models.Date, style models.CalendarStyle, rules []models.TransferRule) ([]transfer, []coincidence) {
+	var transfers []transfer
+	var coincidences []coincidence
+
+	for i := range rules {
+		r := &rules[i]
+		date := civilDate(p.Year, r.Month, r.Day, style)
+		offset := date.Sub(p)
+		if offset < r.PaschaOffsetStart || offset > r.PaschaOffsetEnd {
+			continue
+		}
+
+		switch r.Action {
+		case models.TransferMove:
+			if r.TargetPaschaOffset != nil {
+				transfers = append(transfers, transfer{rule: r, from: date, to: p.AddDays(*r.TargetPaschaOffset)})
+			}
+		case models.TransferMerge, models.TransferCoincidence:
+			coincidences = append(coincidences, coincidence{rule: r, date: date})
+		}
+	}
+
+	return transfers, coincidences
+}
+
+// transferredAway reports whether the fixed feasts of date were moved elsewhere.
+func transferredAway(transfers []transfer, date models.Date) bool {
+	for _, t := range transfers {
+		if t.from == date {
+			return true
+		}
+	}
+	return false
+}
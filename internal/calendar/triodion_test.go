@@ -0,0 +1,111 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"testing"
+)
+
+// triodionSchedule is the Pascha-relative cycle from the Publican and the Pharisee
+// to All Saints as published in the Triodion and Pentecostarion, with the gospel
+// of each day's Liturgy. Days not listed have no moveable commemoration.
+var triodionSchedule = map[int]struct {
+	name   string
+	gospel string
+}{
+	-70: {"Sunday of the Publican and the Pharisee", "Luke 18:10-14"},
+	-63: {"Sunday of the Prodigal Son", "Luke 15:11-32"},
+	-56: {"Meatfare Sunday (Last Judgment)", "Matthew 25:31-46"},
+	-50: {"Saturday of the Holy Ascetics", "Matthew 6:1-13"},
+	-49: {"Cheesefare Sunday (Forgiveness Sunday)", "Matthew 6:14-21"},
+	-48: {"Clean Monday", ""},
+	-43: {"Saturday of St. Theodore the Recruit", "Mark 2:23-3:5"},
+	-42: {"Sunday of Orthodoxy", "John 1:43-51"},
+	-35: {"Sunday of St. Gregory Palamas", "Mark 2:1-12"},
+	-28: {"Sunday of the Veneration of the Cross", "Mark 8:34-9:1"},
+	-21: {"Sunday of St. John Climacus", "Mark 9:17-31"},
+	-16: {"Saturday of the Akathist", "Mark 8:27-31"},
+	-14: {"Sunday of St. Mary of Egypt", "Mark 10:32-45"},
+	-8:  {"Saturday of Lazarus", "John 11:1-45"},
+	-7:  {"Palm Sunday (Entry into Jerusalem)", "John 12:1-18"},
+	-6:  {"Holy (Great) Monday", "Matthew 24:3-35"},
+	-5:  {"Holy (Great) Tuesday", "Matthew 24:36-26:2"},
+	-4:  {"Holy (Great) Wednesday", "Matthew 26:6-16"},
+	-3:  {"Holy (Great) Thursday", "Matthew 26:2-27:2"},
+	-2:  {"Holy (Great) Friday", "Matthew 27:1-38,39-44,45-54,55-61"},
+	-1:  {"Holy (Great) Saturday", "Matthew 28:1-20"},
+	0:   {"Pascha (Resurrection of Christ)", "John 1:1-17"},
+	1:   {"Bright Monday", "John 1:18-28"},
+	2:   {"Bright Tuesday", "Luke 24:12-35"},
+	3:   {"Bright Wednesday", "John 1:35-51"},
+	4:   {"Bright Thursday", "John 3:1-15"},
+	5:   {"Life-giving Spring of the Theotokos", "John 2:12-22"},
+	6:   {"Bright Saturday", "John 3:22-33"},
+	7:   {"Thomas Sunday (Antipascha)", "John 20:19-31"},
+	14:  {"Sunday of the Myrrhbearing Women", "Mark 15:43-16:8"},
+	21:  {"Sunday of the Paralytic", "John 5:1-15"},
+	24:  {"Mid-Pentecost", "John 7:14-30"},
+	28:  {"Sunday of the Samaritan Woman", "John 4:5-42"},
+	35:  {"Sunday of the Blind Man", "John 9:1-38"},
+	39:  {"Ascension of Christ", "Luke 24:36-53"},
+	42:  {"Sunday of the Holy Fathers of the First Ecumenical Council", "John 17:1-13"},
+	49:  {"Pentecost (Descent of the Holy Spirit)", "John 7:37-52,8:12"},
+	50:  {"Monday of the Holy Spirit", "Matthew 18:10-20"},
+	56:  {"All Saints Sunday", "Matthew 10:32-33,37-38,19:27-30"},
+}
+
+func TestTriodionAndPentecostarion(t *testing.T) {
+	cal := newCalendar(t)
+
+	// Years with early, middle and late Pascha
+	for _, year := range []int{2024, 2026, 2027} {
+		p := cal.year(year).pascha
+		for offset := -70; offset <= 56; offset++ {
+			date := p.AddDays(offset)
+			info := cal.GetDayInfo(date)
+
+			var names []string
+			for _, f := range info.Feasts {
+				if f.PaschaOffset != nil {
+					names = append(names, f.Name)
+				}
+			}
+
+			want, scheduled := triodionSchedule[offset]
+			if !scheduled {
+				if len(names) != 0 {
+					t.Errorf("%s (Pascha%+d): unexpected %v", date, offset, names)
+				}
+				continue
+			}
+			if len(names) != 1 || names[0] != want.name {
+				t.Errorf("%s (Pascha%+d): got %v, want %q", date, offset, names, want.name)
+				continue
+			}
+			if want.gospel == "" {
+				continue
+			}
+
+			found := false
+			for _, r := range info.Readings {
+				if r.Gospel != nil && r.Gospel.Book+" "+r.Gospel.Passage == want.gospel {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("%s (%s): gospel %s not in %+v", date, want.name, want.gospel, info.Readings)
+			}
+		}
+	}
+}
+
+func TestMidPentecostAfterfeast(t *testing.T) {
+	cal := newCalendar(t)
+	// Pascha 2026 is April 12; Mid-Pentecost is May 6 and its apodosis May 13
+	info := cal.GetDayInfo(models.NewDate(2026, 5, 13))
+	for _, p := range info.FeastPeriods {
+		if p.Title() == "Apodosis of Mid-Pentecost" {
+			return
+		}
+	}
+	t.Errorf("got %+v, want Apodosis of Mid-Pentecost", info.FeastPeriods)
+}
@@ -0,0 +1,36 @@
+package calendar
+
+import "greekOrtho/internal/models"
+
+// ResolveWeddings reports whether marriages may be celebrated on date and, if
+// not, why. Rules are tried in order and the first match gives the reason;
+// eveOf holds the next day's feasts, for rules that close the eve of a great
+// feast. Fixed-date periods are matched on the calendar style's date.
+func ResolveWeddings(date models.Date, pascha models.Date, style models.CalendarStyle, rules []models.WeddingRule, eveOf []models.Feast) (bool, string) {
+	daysFromPascha := date.Sub(pascha)
+	fixed := fixedDate(date, style)
+
+	for i := range rules {
+		r := &rules[i]
+		if r.GreatFeastEve {
+			if f := greatFeast(eveOf); f != nil {
+				return false, r.Description + " (" + f.Name + ")"
+			}
+			continue
+		}
+		if ruleMatches(date, fixed, daysFromPascha, pascha, style, &r.DateSpan) {
+			return false, r.Description
+		}
+	}
+	return true, "Weddings may be celebrated"
+}
+
+// greatFeast returns the first great feast in feasts, or nil if there is none.
+func greatFeast(feasts []models.Feast) *models.Feast {
+	for i := range feasts {
+		if feasts[i].Rank == models.RankGreat {
+			return &feasts[i]
+		}
+	}
+	return nil
+}
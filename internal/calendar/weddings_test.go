@@ -0,0 +1,62 @@
+package calendar
+
+import (
+	"greekOrtho/internal/models"
+	"strings"
+	"testing"
+)
+
+func TestWeddings(t *testing.T) {
+	cal := newCalendar(t)
+	tests := []struct {
+		date models.Date
+		want bool
+	}{
+		// Pascha 2026 is April 12
+		{models.NewDate(2026, 2, 15), true},   // Meatfare Sunday
+		{models.NewDate(2026, 2, 16), false},  // Monday of Cheesefare Week
+		{models.NewDate(2026, 4, 19), false},  // Thomas Sunday
+		{models.NewDate(2026, 4, 20), true},   // the Monday after
+		{models.NewDate(2026, 5, 20), false},  // eve of the Ascension
+		{models.NewDate(2026, 6, 7), true},    // All Saints
+		{models.NewDate(2026, 6, 8), false},   // Apostles' Fast
+		{models.NewDate(2026, 8, 10), false},  // Dormition Fast
+		{models.NewDate(2026, 8, 29), false},  // Beheading of the Forerunner
+		{models.NewDate(2026, 9, 7), false},   // eve of the Nativity of the Theotokos
+		{models.NewDate(2026, 9, 14), false},  // Elevation of the Cross
+		{models.NewDate(2026, 10, 17), false}, // a Saturday
+		{models.NewDate(2026, 10, 18), true},  // a Sunday
+		{models.NewDate(2026, 11, 16), false}, // Nativity Fast
+		{models.NewDate(2027, 1, 4), false},   // Twelve Days
+		{models.NewDate(2027, 1, 7), true},    // Synaxis of the Forerunner
+	}
+
+	for _, tt := range tests {
+		info := cal.GetDayInfo(tt.date)
+		if info.Weddings != tt.want {
+			t.Errorf("%s: weddings %v, want %v (%s)", tt.date, info.Weddings, tt.want, info.WeddingReason)
+		}
+	}
+}
+
+func TestWeddings_GreatFeastEve(t *testing.T) {
+	cal := newCalendar(t)
+	info := cal.GetDayInfo(models.NewDate(2026, 9, 7))
+	if !strings.Contains(info.WeddingReason, "Nativity of the Theotokos") {
+		t.Errorf("expected the eve's feast in the reason, got %q", info.WeddingReason)
+	}
+}
+
+func TestWeddings_Julian(t *testing.T) {
+	d := newCalendar(t).data
+	cal := New(d, models.StyleJulian)
+
+	// Nov 16 is in the Nativity Fast on the New Calendar but is Nov 3 O.S.
+	if info := cal.GetDayInfo(models.NewDate(2026, 11, 16)); !info.Weddings {
+		t.Errorf("Nov 3 O.S.: expected weddings, got %q", info.WeddingReason)
+	}
+	// Jan 19, 2027 is Theophany O.S.
+	if info := cal.GetDayInfo(models.NewDate(2027, 1, 19)); info.Weddings {
+		t.Error("Theophany O.S.: expected no weddings")
+	}
+}
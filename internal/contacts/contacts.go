@@ -0,0 +1,220 @@
+// Package contacts reads the user's address book from a vCard or CSV file.
+package contacts
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"greekOrtho/internal/models"
+	"io"
+	"mime/quotedprintable"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Files are the names of the address books looked for in the user's
+// configuration directory, in order.
+var Files = []string{"contacts.vcf", "contacts.csv"}
+
+// Load reads the contacts in the file at path: a vCard file (.vcf, .vcard)
+// or a CSV file with a header row (.csv).
+func Load(path string) ([]models.Contact, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var contacts []models.Contact
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".vcf", ".vcard":
+		contacts, err = ParseVCard(f)
+	case ".csv":
+		contacts, err = ParseCSV(f)
+	default:
+		return nil, fmt.Errorf("%s: unknown address book format %q (use .vcf or .csv)", path, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return contacts, nil
+}
+
+// ParseVCard reads the contacts in a vCard (2.1, 3.0 or 4.0) stream. Each card's
+// name comes from FN, or from N when FN is missing; the given name from N.
+// Cards with neither are skipped.
+func ParseVCard(r io.Reader) ([]models.Contact, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var contacts []models.Contact
+	var c *models.Contact
+	for i, l := range lines {
+		name, value, ok := strings.Cut(l, ":")
+		if !ok {
+			continue
+		}
+		// Drop parameters ("FN;CHARSET=UTF-8") and groups ("item1.FN"),
+		// decoding quoted-printable values (vCard 2.1).
+		name, params, _ := strings.Cut(name, ";")
+		if _, after, ok := strings.Cut(name, "."); ok {
+			name = after
+		}
+		if quotedPrintable(params) {
+			b, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(value)))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+			value = string(b)
+		}
+
+		switch strings.ToUpper(name) {
+		case "BEGIN":
+			if strings.EqualFold(value, "VCARD") {
+				c = &models.Contact{}
+			}
+		case "END":
+			if !strings.EqualFold(value, "VCARD") {
+				continue
+			}
+			if c == nil {
+				return nil, fmt.Errorf("line %d: END:VCARD without BEGIN:VCARD", i+1)
+			}
+			if c.Name != "" {
+				contacts = append(contacts, *c)
+			}
+			c = nil
+		case "FN":
+			if c != nil {
+				c.Name = unescape(value)
+			}
+		case "N":
+			if c == nil {
+				continue
+			}
+			// Family;Given;Additional;Prefixes;Suffixes
+			parts := strings.Split(value, ";")
+			if len(parts) > 1 {
+				c.GivenName = unescape(parts[1])
+			}
+			if c.Name == "" {
+				c.Name = strings.TrimSpace(c.GivenName + " " + unescape(parts[0]))
+			}
+		}
+	}
+	if c != nil {
+		return nil, errors.New("BEGIN:VCARD without END:VCARD")
+	}
+	return contacts, nil
+}
+
+// unfold reads the lines of a vCard stream, joining folded continuation
+// lines (those starting with a space or tab) to the line before, as well as
+// the lines of a quoted-printable value that end in a soft line break ("=").
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	softBreak := false
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		l := strings.TrimRight(sc.Text(), "\r")
+		if len(lines) == 0 {
+			l = strings.TrimPrefix(l, "\ufeff")
+		}
+		switch {
+		case softBreak:
+			lines[len(lines)-1] = strings.TrimSuffix(lines[len(lines)-1], "=") + l
+		case len(lines) > 0 && (strings.HasPrefix(l, " ") || strings.HasPrefix(l, "\t")):
+			lines[len(lines)-1] += l[1:]
+		default:
+			lines = append(lines, l)
+		}
+		last := lines[len(lines)-1]
+		name, _, _ := strings.Cut(last, ":")
+		_, params, _ := strings.Cut(name, ";")
+		softBreak = strings.HasSuffix(last, "=") && quotedPrintable(params)
+	}
+	return lines, sc.Err()
+}
+
+// quotedPrintable reports whether a property's parameters, e.g.
+// "CHARSET=UTF-8;ENCODING=QUOTED-PRINTABLE", mark its value quoted-printable.
+func quotedPrintable(params string) bool {
+	for _, p := range strings.Split(params, ";") {
+		if strings.EqualFold(p, "ENCODING=QUOTED-PRINTABLE") || strings.EqualFold(p, "QUOTED-PRINTABLE") {
+			return true
+		}
+	}
+	return false
+}
+
+// unescape undoes vCard text escaping.
+var unescape = strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ", `\\`, `\`).Replace
+
+// CSV header names, compared case-insensitively, as exported by common
+// address books (Google, Outlook, Apple).
+var (
+	nameColumns   = []string{"name", "full name", "display name"}
+	givenColumns  = []string{"given name", "first name"}
+	familyColumns = []string{"family name", "last name"}
+)
+
+// ParseCSV reads the contacts in a CSV stream whose first row names the
+// columns. It needs a full name column or a given name column; rows with
+// neither are skipped.
+func ParseCSV(r io.Reader) ([]models.Contact, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	column := func(names []string) int {
+		for i, h := range header {
+			h = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+			for _, n := range names {
+				if h == n {
+					return i
+				}
+			}
+		}
+		return -1
+	}
+	nameCol, givenCol, familyCol := column(nameColumns), column(givenColumns), column(familyColumns)
+	if nameCol < 0 && givenCol < 0 {
+		return nil, errors.New("no name column (expected \"Name\" or \"First Name\")")
+	}
+
+	field := func(row []string, i int) string {
+		if i < 0 || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	var contacts []models.Contact
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		c := models.Contact{Name: field(row, nameCol), GivenName: field(row, givenCol)}
+		if c.Name == "" {
+			c.Name = strings.TrimSpace(c.GivenName + " " + field(row, familyCol))
+		}
+		if c.Name != "" {
+			contacts = append(contacts, c)
+		}
+	}
+	return contacts, nil
+}
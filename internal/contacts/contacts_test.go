@@ -0,0 +1,129 @@
+package contacts
+
+import (
+	"greekOrtho/internal/models"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseVCard(t *testing.T) {
+	vcf := strings.Join([]string{
+		"BEGIN:VCARD",
+		"VERSION:3.0",
+		"FN:Giorgos Papadopoulos",
+		"N:Papadopoulos;Giorgos;;;",
+		"END:VCARD",
+		"BEGIN:VCARD",
+		"VERSION:4.0",
+		"N:Doe;Jane;;;", // no FN: the name is built from N
+		"END:VCARD",
+		"BEGIN:VCARD",
+		"item1.FN;CHARSET=UTF-8:Ελένη", // group and parameter
+		"  Κωνσταντίνου",               // folded line
+		"END:VCARD",
+		"BEGIN:VCARD",
+		"TEL:123", // no name: skipped
+		"END:VCARD",
+	}, "\r\n")
+
+	got, err := ParseVCard(strings.NewReader(vcf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []models.Contact{
+		{Name: "Giorgos Papadopoulos", GivenName: "Giorgos"},
+		{Name: "Jane Doe", GivenName: "Jane"},
+		{Name: "Ελένη Κωνσταντίνου"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseVCard = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseVCard_ByteOrderMark(t *testing.T) {
+	got, err := ParseVCard(strings.NewReader("\ufeffBEGIN:VCARD\r\nFN:Anna\r\nEND:VCARD\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []models.Contact{{Name: "Anna"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseVCard = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseVCard_QuotedPrintable(t *testing.T) {
+	// vCard 2.1 as exported by Android, with a soft line break in FN
+	vcf := strings.Join([]string{
+		"BEGIN:VCARD",
+		"VERSION:2.1",
+		"N;CHARSET=UTF-8;ENCODING=QUOTED-PRINTABLE:=CE=9A=CF=89=CE=BD=CF=83=CF=84=CE=B1=CE=BD=CF=84=CE=AF=CE=BD=CE=BF=CF=85;=CE=9C=CE=B1=CF=81=CE=AF=CE=B1;;;",
+		"FN;CHARSET=UTF-8;ENCODING=QUOTED-PRINTABLE:=CE=9C=CE=B1=CF=81=CE=AF=CE=B1 =CE=9A=CF=89=CE=BD=CF=83=CF=84=CE=B1=CE=BD=",
+		"=CF=84=CE=AF=CE=BD=CE=BF=CF=85",
+		"TEL;CELL:123",
+		"END:VCARD",
+	}, "\r\n")
+
+	got, err := ParseVCard(strings.NewReader(vcf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []models.Contact{{Name: "Μαρία Κωνσταντίνου", GivenName: "Μαρία"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseVCard = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseVCard_Unterminated(t *testing.T) {
+	if _, err := ParseVCard(strings.NewReader("BEGIN:VCARD\nFN:Anna\n")); err == nil {
+		t.Error("expected an error for a card without END:VCARD")
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	tests := []struct {
+		name string
+		csv  string
+		want []models.Contact
+	}{
+		{
+			"first and last name",
+			"First Name,Last Name,Phone\nMaria,Kosta,123\nDimitris,,\n,,456\n",
+			[]models.Contact{
+				{Name: "Maria Kosta", GivenName: "Maria"},
+				{Name: "Dimitris", GivenName: "Dimitris"},
+			},
+		},
+		{
+			"full name with BOM",
+			"\ufeffName,Email\nNikos Pappas,n@example.com\n",
+			[]models.Contact{{Name: "Nikos Pappas"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCSV(strings.NewReader(tt.csv))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseCSV = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParseCSV(strings.NewReader("Phone,Email\n123,a@example.com\n")); err == nil {
+		t.Error("expected an error for a CSV without a name column")
+	}
+}
+
+func TestLoad_UnknownFormat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "contacts.txt")
+	if err := os.WriteFile(path, []byte("Anna"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("expected an error for a .txt address book")
+	}
+}
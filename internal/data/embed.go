@@ -16,9 +16,18 @@ var moveableFeastsJSON []byte
 //go:embed saints.json
 var saintsJSON []byte
 
+//go:embed namedays.json
+var nameDaysJSON []byte
+
 //go:embed fasting_rules.json
 var fastingRulesJSON []byte
 
+//go:embed wedding_rules.json
+var weddingRulesJSON []byte
+
+//go:embed kneeling_rules.json
+var kneelingRulesJSON []byte
+
 //go:embed quotes.json
 var quotesJSON []byte
 
@@ -31,15 +40,26 @@ var gospelCycleJSON []byte
 //go:embed feast_readings.json
 var feastReadingsJSON []byte
 
+//go:embed transfer_rules.json
+var transferRulesJSON []byte
+
+//go:embed memorials.json
+var memorialsJSON []byte
+
+//go:embed foods.json
+var foodsJSON []byte
+
 // EpistleCycle maps week-of-Pentecost (string) → weekday (string "0"-"6") → reading.
 type EpistleCycle map[string]map[string]models.ScriptureReading
 
-// GospelCycle contains the four gospel series.
+// GospelCycle contains the four gospel series and the eleven Resurrection
+// (Eothinon) Gospels read at Sunday Matins.
 type GospelCycle struct {
 	John    map[string]map[string]models.ScriptureReading `json:"john"`
 	Matthew map[string]map[string]models.ScriptureReading `json:"matthew"`
 	Luke    map[string]map[string]models.ScriptureReading `json:"luke"`
 	Lenten  map[string]map[string]models.ScriptureReading `json:"lenten"`
+	Eothina map[string]models.ScriptureReading            `json:"eothina"` // "1"-"11"
 }
 
 // FeastReadingEntry holds a single feast's readings.
@@ -49,10 +69,12 @@ type FeastReadingEntry struct {
 	Gospel  *models.ScriptureReading `json:"gospel,omitempty"`
 }
 
-// FeastReadings holds fixed (by month/day) and moveable (by pascha-offset) feast readings.
+// FeastReadings holds fixed (by month/day), moveable (by pascha-offset) and
+// weekday-anchored (by feast name) feast readings.
 type FeastReadings struct {
 	Fixed    map[string]FeastReadingEntry `json:"fixed"`
 	Moveable map[string]FeastReadingEntry `json:"moveable"`
+	Anchored map[string]FeastReadingEntry `json:"anchored"`
 }
 
 // CalendarData holds all loaded calendar data.
@@ -60,11 +82,17 @@ type CalendarData struct {
 	FixedFeasts    []models.Feast
 	MoveableFeasts []models.Feast
 	Saints         []models.Saint
+	NameDays       []models.NameDay
 	FastingRules   []models.FastingRule
+	WeddingRules   []models.WeddingRule
+	KneelingRules  []models.KneelingRule
 	Quotes         []models.Quote
 	EpistleCycle   EpistleCycle
 	GospelCycle    GospelCycle
 	FeastReadings  FeastReadings
+	TransferRules  []models.TransferRule
+	Memorials      []models.Memorial
+	Foods          []models.FoodItem
 }
 
 // Load parses all embedded JSON data and returns a CalendarData struct.
@@ -80,9 +108,18 @@ func Load() (*CalendarData, error) {
 	if err := json.Unmarshal(saintsJSON, &d.Saints); err != nil {
 		return nil, fmt.Errorf("parsing saints.json: %w", err)
 	}
+	if err := json.Unmarshal(nameDaysJSON, &d.NameDays); err != nil {
+		return nil, fmt.Errorf("parsing namedays.json: %w", err)
+	}
 	if err := json.Unmarshal(fastingRulesJSON, &d.FastingRules); err != nil {
 		return nil, fmt.Errorf("parsing fasting_rules.json: %w", err)
 	}
+	if err := json.Unmarshal(weddingRulesJSON, &d.WeddingRules); err != nil {
+		return nil, fmt.Errorf("parsing wedding_rules.json: %w", err)
+	}
+	if err := json.Unmarshal(kneelingRulesJSON, &d.KneelingRules); err != nil {
+		return nil, fmt.Errorf("parsing kneeling_rules.json: %w", err)
+	}
 	if err := json.Unmarshal(quotesJSON, &d.Quotes); err != nil {
 		return nil, fmt.Errorf("parsing quotes.json: %w", err)
 	}
@@ -95,9 +132,40 @@ func Load() (*CalendarData, error) {
 	if err := json.Unmarshal(feastReadingsJSON, &d.FeastReadings); err != nil {
 		return nil, fmt.Errorf("parsing feast_readings.json: %w", err)
 	}
+	if err := json.Unmarshal(transferRulesJSON, &d.TransferRules); err != nil {
+		return nil, fmt.Errorf("parsing transfer_rules.json: %w", err)
+	}
+	if err := json.Unmarshal(memorialsJSON, &d.Memorials); err != nil {
+		return nil, fmt.Errorf("parsing memorials.json: %w", err)
+	}
+	if err := json.Unmarshal(foodsJSON, &d.Foods); err != nil {
+		return nil, fmt.Errorf("parsing foods.json: %w", err)
+	}
 
 	return &d, nil
 }
 
+// LoadWithOverlays is Load with the overlays of a profile and of the user
+// applied. A non-empty profile names the jurisdiction profile whose overlays to
+// apply (see Profiles). A non-empty userDir is a directory of the user's own
+// overlay files, applied last; it must exist.
+func LoadWithOverlays(profile, userDir string) (*CalendarData, error) {
+	d, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	if profile != "" {
+		if _, err := applyProfile(d, profile); err != nil {
+			return nil, err
+		}
+	}
+	if userDir != "" {
+		if _, err := applyUserDir(d, userDir); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
 // Ensure embed import is used (for go:embed directives).
 var _ embed.FS
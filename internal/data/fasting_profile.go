@@ -0,0 +1,89 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"greekOrtho/internal/models"
+	"os"
+)
+
+// FastingProfileFile is the name of the fasting profile in the user's
+// configuration directory.
+const FastingProfileFile = "fasting.json"
+
+// LoadFastingProfile reads and checks the fasting profile at path. Errors
+// name the file and, where they can, the JSON path at fault.
+func LoadFastingProfile(path string) (models.FastingProfile, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return models.FastingProfile{}, err
+	}
+
+	var p models.FastingProfile
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&p); err != nil {
+		return models.FastingProfile{}, overlayError(path, raw, err)
+	}
+	if p.Practice == "" {
+		p.Practice = models.PracticeLay
+	}
+	if err := checkFastingProfile(p); err != nil {
+		return models.FastingProfile{}, overlayError(path, raw, err)
+	}
+	return p, nil
+}
+
+func checkFastingProfile(p models.FastingProfile) error {
+	switch p.Practice {
+	case models.PracticeMonastic, models.PracticeLay, models.PracticeLenient:
+		if len(p.Permit) > 0 || len(p.Forbid) > 0 {
+			return errors.New("permit and forbid need the custom profile")
+		}
+	case models.PracticeCustom:
+	default:
+		return fieldError("profile", "must be monastic, lay, lenient or custom, got %q", p.Practice)
+	}
+	if err := checkFoods("permit", p.Permit); err != nil {
+		return err
+	}
+	if err := checkFoods("forbid", p.Forbid); err != nil {
+		return err
+	}
+
+	for i, d := range p.Dispensations {
+		field := fmt.Sprintf("dispensations[%d]", i)
+		if d.From == (models.Date{}) || d.To == (models.Date{}) {
+			return fieldError(field, "needs from and to")
+		}
+		if d.To.Before(d.From) {
+			return fieldError(field+".to", "%s is before from %s", d.To, d.From)
+		}
+		if d.Reason == "" {
+			return fieldError(field+".reason", "is required")
+		}
+		if d.Level == "" && len(d.Permit) == 0 {
+			return fieldError(field, "needs level or permit")
+		}
+		if d.Level != "" {
+			if err := checkFastingLevel(field+".level", d.Level); err != nil {
+				return err
+			}
+		}
+		if err := checkFoods(field+".permit", d.Permit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkFoods(field string, foods []models.Food) error {
+	for i, f := range foods {
+		if models.NewFoodSet(f) == 0 {
+			return fieldError(fmt.Sprintf("%s[%d]", field, i), "unknown food %q", f)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,351 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"greekOrtho/internal/models"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+)
+
+// Change is one entry an overlay adds, replaces or removes.
+type Change struct {
+	File   string // Data file, e.g. "saints.json"
+	Action string // "add", "replace" or "remove"
+	Key    string // Key of the entry, e.g. "10/1 Protection of the Theotokos"
+}
+
+// OverlayError reports an invalid overlay file and where in it the problem is.
+type OverlayError struct {
+	File string // Path of the overlay file
+	Path string // JSON path of the offending value, e.g. "add[2].month"; empty for the whole file
+	Err  error
+}
+
+func (e *OverlayError) Error() string {
+	if e.Path == "" {
+		return e.File + ": " + e.Err.Error()
+	}
+	return e.File + ": " + e.Path + ": " + e.Err.Error()
+}
+
+func (e *OverlayError) Unwrap() error { return e.Err }
+
+// pathError is an error at a JSON path within an overlay file; the caller
+// turns it into an OverlayError once the file is known.
+type pathError struct {
+	path string
+	err  error
+}
+
+func (e *pathError) Error() string { return e.path + ": " + e.err.Error() }
+
+// listOverlay changes a list of entries by key. Removals are applied first,
+// then replacements, then additions. Entries are decoded one at a time so
+// errors can name the entry they are in.
+type listOverlay struct {
+	Remove  []string          `json:"remove,omitempty"`
+	Replace []json.RawMessage `json:"replace,omitempty"`
+	Add     []json.RawMessage `json:"add,omitempty"`
+}
+
+// mapOverlay changes a map of entries by key, in the same order as listOverlay.
+type mapOverlay struct {
+	Remove  []string                   `json:"remove,omitempty"`
+	Replace map[string]json.RawMessage `json:"replace,omitempty"`
+	Add     map[string]json.RawMessage `json:"add,omitempty"`
+}
+
+// feastReadingsOverlay changes each section of feast_readings.json.
+type feastReadingsOverlay struct {
+	Fixed    mapOverlay `json:"fixed"`
+	Moveable mapOverlay `json:"moveable"`
+	Anchored mapOverlay `json:"anchored"`
+}
+
+// overlayFile applies the overlay for one data file to d.
+type overlayFile struct {
+	name  string
+	apply func(d *CalendarData, raw []byte) ([]Change, error)
+}
+
+// overlayFiles lists the data files an overlay may change, with how their
+// entries are keyed: feasts, rules, memorials, name days and foods by name,
+// saints by "month/day name", quotes by their text, and feast readings by
+// their key within each section.
+var overlayFiles = []overlayFile{
+	{"fixed_feasts.json", func(d *CalendarData, raw []byte) ([]Change, error) {
+		return applyList(raw, &d.FixedFeasts, feastKey, checkFixedFeast)
+	}},
+	{"moveable_feasts.json", func(d *CalendarData, raw []byte) ([]Change, error) {
+		return applyList(raw, &d.MoveableFeasts, feastKey, checkMoveableFeast)
+	}},
+	{"saints.json", func(d *CalendarData, raw []byte) ([]Change, error) {
+		return applyList(raw, &d.Saints, saintKey, checkSaint)
+	}},
+	{"namedays.json", func(d *CalendarData, raw []byte) ([]Change, error) {
+		return applyList(raw, &d.NameDays, nameDayKey, checkNameDay)
+	}},
+	{"fasting_rules.json", func(d *CalendarData, raw []byte) ([]Change, error) {
+		return applyList(raw, &d.FastingRules, fastingRuleKey, checkFastingRule)
+	}},
+	{"wedding_rules.json", func(d *CalendarData, raw []byte) ([]Change, error) {
+		return applyList(raw, &d.WeddingRules, weddingKey, checkWeddingRule)
+	}},
+	{"kneeling_rules.json", func(d *CalendarData, raw []byte) ([]Change, error) {
+		return applyList(raw, &d.KneelingRules, kneelingKey, checkKneelingRule)
+	}},
+	{"transfer_rules.json", func(d *CalendarData, raw []byte) ([]Change, error) {
+		return applyList(raw, &d.TransferRules, transferKey, checkTransferRule)
+	}},
+	{"memorials.json", func(d *CalendarData, raw []byte) ([]Change, error) {
+		return applyList(raw, &d.Memorials, memorialKey, checkMemorial)
+	}},
+	{"quotes.json", func(d *CalendarData, raw []byte) ([]Change, error) {
+		return applyList(raw, &d.Quotes, quoteKey, checkQuote)
+	}},
+	{"foods.json", func(d *CalendarData, raw []byte) ([]Change, error) {
+		return applyList(raw, &d.Foods, foodKey, checkFoodItem)
+	}},
+	{"feast_readings.json", applyFeastReadings},
+}
+
+func feastKey(f models.Feast) string             { return f.Name }
+func saintKey(s models.Saint) string             { return fmt.Sprintf("%d/%d %s", s.Month, s.Day, s.Name) }
+func nameDayKey(n models.NameDay) string         { return n.Name }
+func fastingRuleKey(r models.FastingRule) string { return r.Name }
+func weddingKey(r models.WeddingRule) string     { return r.Name }
+func kneelingKey(r models.KneelingRule) string   { return r.Name }
+func transferKey(r models.TransferRule) string   { return r.Name }
+func memorialKey(m models.Memorial) string       { return m.Name }
+func quoteKey(q models.Quote) string             { return q.Text }
+func foodKey(f models.FoodItem) string           { return f.Name }
+
+// isOverlayFile reports whether name is a data file an overlay may change.
+func isOverlayFile(name string) bool {
+	for _, o := range overlayFiles {
+		if o.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// applyOverlays applies the overlay files found in dir of fsys to d. Missing
+// files are skipped; errors name each file as label/name.
+func applyOverlays(d *CalendarData, fsys fs.FS, dir, label string) ([]Change, error) {
+	var changes []Change
+	for _, o := range overlayFiles {
+		raw, err := fs.ReadFile(fsys, path.Join(dir, o.name))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		file := path.Join(label, o.name)
+		if err != nil {
+			return nil, &OverlayError{File: file, Err: err}
+		}
+
+		cs, err := o.apply(d, raw)
+		if err != nil {
+			return nil, overlayError(file, raw, err)
+		}
+		for i := range cs {
+			cs[i].File = o.name
+		}
+		changes = append(changes, cs...)
+	}
+	return changes, nil
+}
+
+// overlayError wraps an error from applying the overlay in file, locating
+// syntax errors by line and column.
+func overlayError(file string, raw []byte, err error) error {
+	var pe *pathError
+	if errors.As(err, &pe) {
+		return &OverlayError{File: file, Path: pe.path, Err: pe.err}
+	}
+	var se *json.SyntaxError
+	if errors.As(err, &se) {
+		line, col := position(raw, se.Offset-1) // Offset is just past the bad byte
+		return &OverlayError{File: file, Err: fmt.Errorf("line %d, column %d: %w", line, col, se)}
+	}
+	var te *json.UnmarshalTypeError
+	if errors.As(err, &te) {
+		return &OverlayError{File: file, Path: te.Field, Err: fmt.Errorf("expected %s, got %s", te.Type, te.Value)}
+	}
+	return &OverlayError{File: file, Err: err}
+}
+
+// position converts a byte offset in raw to a 1-based line and column.
+func position(raw []byte, offset int64) (int, int) {
+	offset = max(0, min(offset, int64(len(raw))))
+	before := raw[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	col := int(offset) - bytes.LastIndexByte(before, '\n')
+	return line, col
+}
+
+// decodeEntry decodes one overlay entry at path, checking it with check.
+func decodeEntry[T any](raw json.RawMessage, path string, check func(T) error) (T, error) {
+	var v T
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&v); err != nil {
+		var te *json.UnmarshalTypeError
+		if errors.As(err, &te) {
+			if te.Field != "" {
+				path += "." + te.Field
+			}
+			return v, &pathError{path, fmt.Errorf("expected %s, got %s", te.Type, te.Value)}
+		}
+		return v, &pathError{path, err}
+	}
+	if check != nil {
+		if err := check(v); err != nil {
+			var pe *pathError
+			if errors.As(err, &pe) {
+				return v, &pathError{path + "." + pe.path, pe.err}
+			}
+			return v, &pathError{path, err}
+		}
+	}
+	return v, nil
+}
+
+// applyList applies a listOverlay in raw to list, whose entries are
+// identified by key and validated by check.
+func applyList[T any](raw []byte, list *[]T, key func(T) string, check func(T) error) ([]Change, error) {
+	var o listOverlay
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&o); err != nil {
+		return nil, err
+	}
+
+	index := func(k string) int {
+		for i, e := range *list {
+			if key(e) == k {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var changes []Change
+	for i, k := range o.Remove {
+		j := index(k)
+		if j < 0 {
+			return nil, &pathError{fmt.Sprintf("remove[%d]", i), fmt.Errorf("no entry %q", k)}
+		}
+		*list = append((*list)[:j], (*list)[j+1:]...)
+		changes = append(changes, Change{Action: "remove", Key: k})
+	}
+	for i, r := range o.Replace {
+		p := fmt.Sprintf("replace[%d]", i)
+		e, err := decodeEntry(r, p, check)
+		if err != nil {
+			return nil, err
+		}
+		k := key(e)
+		j := index(k)
+		if j < 0 {
+			return nil, &pathError{p, fmt.Errorf("no entry %q to replace", k)}
+		}
+		(*list)[j] = e
+		changes = append(changes, Change{Action: "replace", Key: k})
+	}
+	for i, r := range o.Add {
+		p := fmt.Sprintf("add[%d]", i)
+		e, err := decodeEntry(r, p, check)
+		if err != nil {
+			return nil, err
+		}
+		k := key(e)
+		if index(k) >= 0 {
+			return nil, &pathError{p, fmt.Errorf("entry %q already exists; use replace", k)}
+		}
+		*list = append(*list, e)
+		changes = append(changes, Change{Action: "add", Key: k})
+	}
+	return changes, nil
+}
+
+// applyMap applies o to m. Paths and change keys are prefixed with section.
+func applyMap[T any](o mapOverlay, m map[string]T, section string) ([]Change, error) {
+	var changes []Change
+	for i, k := range o.Remove {
+		if _, ok := m[k]; !ok {
+			return nil, &pathError{fmt.Sprintf("%s.remove[%d]", section, i), fmt.Errorf("no entry %q", k)}
+		}
+		delete(m, k)
+		changes = append(changes, Change{Action: "remove", Key: section + " " + k})
+	}
+	for _, k := range sortedKeys(o.Replace) {
+		p := section + ".replace." + strconv.Quote(k)
+		if _, ok := m[k]; !ok {
+			return nil, &pathError{p, fmt.Errorf("no entry %q to replace", k)}
+		}
+		e, err := decodeEntry[T](o.Replace[k], p, nil)
+		if err != nil {
+			return nil, err
+		}
+		m[k] = e
+		changes = append(changes, Change{Action: "replace", Key: section + " " + k})
+	}
+	for _, k := range sortedKeys(o.Add) {
+		p := section + ".add." + strconv.Quote(k)
+		if _, ok := m[k]; ok {
+			return nil, &pathError{p, fmt.Errorf("entry %q already exists; use replace", k)}
+		}
+		e, err := decodeEntry[T](o.Add[k], p, nil)
+		if err != nil {
+			return nil, err
+		}
+		m[k] = e
+		changes = append(changes, Change{Action: "add", Key: section + " " + k})
+	}
+	return changes, nil
+}
+
+func applyFeastReadings(d *CalendarData, raw []byte) ([]Change, error) {
+	var o feastReadingsOverlay
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&o); err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	for _, s := range []struct {
+		name string
+		o    mapOverlay
+		m    *map[string]FeastReadingEntry
+	}{
+		{"fixed", o.Fixed, &d.FeastReadings.Fixed},
+		{"moveable", o.Moveable, &d.FeastReadings.Moveable},
+		{"anchored", o.Anchored, &d.FeastReadings.Anchored},
+	} {
+		if *s.m == nil {
+			*s.m = make(map[string]FeastReadingEntry)
+		}
+		cs, err := applyMap(s.o, *s.m, s.name)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, cs...)
+	}
+	return changes, nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
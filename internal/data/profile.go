@@ -0,0 +1,86 @@
+package data
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+)
+
+//go:embed profiles
+var profilesFS embed.FS
+
+// Profile describes a jurisdiction's variant of the calendar: a directory of
+// overlay files, named after the data files they change, plus a profile.json
+// with its title and description.
+type Profile struct {
+	Name        string `json:"-"` // Directory name, as given to -profile
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// Profiles returns the available profiles sorted by name.
+func Profiles() ([]Profile, error) {
+	entries, err := fs.ReadDir(profilesFS, "profiles")
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []Profile
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		p, err := readProfile(e.Name())
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, p)
+	}
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Name < profiles[j].Name })
+	return profiles, nil
+}
+
+// ProfileChanges returns the entries the named profile adds, replaces or
+// removes, in the order its overlay files are applied.
+func ProfileChanges(name string) ([]Change, error) {
+	d, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	return applyProfile(d, name)
+}
+
+func readProfile(name string) (Profile, error) {
+	raw, err := fs.ReadFile(profilesFS, path.Join("profiles", name, "profile.json"))
+	if err != nil {
+		return Profile{}, fmt.Errorf("unknown profile %q", name)
+	}
+	var p Profile
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return Profile{}, fmt.Errorf("parsing profile %s: profile.json: %w", name, err)
+	}
+	p.Name = name
+	return p, nil
+}
+
+// applyProfile applies the named profile's overlay files to d.
+func applyProfile(d *CalendarData, name string) ([]Change, error) {
+	if _, err := readProfile(name); err != nil {
+		return nil, err
+	}
+
+	dir := path.Join("profiles", name)
+	entries, err := fs.ReadDir(profilesFS, dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if e.Name() != "profile.json" && !isOverlayFile(e.Name()) {
+			return nil, fmt.Errorf("profile %s: %s is not a data file a profile can change", name, e.Name())
+		}
+	}
+	return applyOverlays(d, profilesFS, dir, "profile "+name)
+}
@@ -0,0 +1,37 @@
+package data
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// appName names the user's configuration directory.
+const appName = "orthoCal"
+
+// UserDir returns the default directory of the user's overlay files:
+// $XDG_CONFIG_HOME/orthoCal, or ~/.config/orthoCal when XDG_CONFIG_HOME is
+// unset. It returns "" if neither can be determined.
+func UserDir() string {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, appName)
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", appName)
+}
+
+// applyUserDir applies the overlay files in dir to d. Files in dir that are
+// not data files are ignored, so it can hold other configuration.
+func applyUserDir(d *CalendarData, dir string) ([]Change, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("data directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("data directory %s is not a directory", dir)
+	}
+	return applyOverlays(d, os.DirFS(dir), ".", dir)
+}
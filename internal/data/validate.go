@@ -0,0 +1,263 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"greekOrtho/internal/models"
+)
+
+// Checks run on each entry an overlay adds or replaces. They return a
+// pathError naming the field at fault, or a plain error for the entry as a whole.
+
+func fieldError(field, format string, args ...any) error {
+	return &pathError{field, fmt.Errorf(format, args...)}
+}
+
+func checkName(name string) error {
+	if name == "" {
+		return fieldError("name", "is required")
+	}
+	return nil
+}
+
+func checkMonthDay(month, day int) error {
+	if month < 1 || month > 12 {
+		return fieldError("month", "must be 1-12, got %d", month)
+	}
+	if day < 1 || day > 31 {
+		return fieldError("day", "must be 1-31, got %d", day)
+	}
+	return nil
+}
+
+func checkRank(r models.FeastRank) error {
+	switch r {
+	case models.RankGreat, models.RankMajor, models.RankMinor:
+		return nil
+	}
+	return fieldError("rank", "must be great, major or minor, got %q", r)
+}
+
+func checkKind(field string, k models.FeastKind) error {
+	switch k {
+	case models.KindLord, models.KindTheotokos:
+		return nil
+	}
+	return fieldError(field, "must be lord or theotokos, got %q", k)
+}
+
+func checkFastingLevel(field string, l models.FastingLevel) error {
+	if !l.Known() {
+		return fieldError(field, "unknown fasting level %q", l)
+	}
+	return nil
+}
+
+func checkFeast(f models.Feast) error {
+	if err := checkName(f.Name); err != nil {
+		return err
+	}
+	if err := checkRank(f.Rank); err != nil {
+		return err
+	}
+	if f.Kind != "" {
+		if err := checkKind("kind", f.Kind); err != nil {
+			return err
+		}
+	}
+	if f.FastingOverride != nil {
+		return checkFastingLevel("fasting_override", *f.FastingOverride)
+	}
+	return nil
+}
+
+func checkFixedFeast(f models.Feast) error {
+	if err := checkFeast(f); err != nil {
+		return err
+	}
+	if f.Month == nil || f.Day == nil {
+		return errors.New("a fixed feast needs month and day")
+	}
+	return checkMonthDay(*f.Month, *f.Day)
+}
+
+func checkMoveableFeast(f models.Feast) error {
+	if err := checkFeast(f); err != nil {
+		return err
+	}
+	if (f.PaschaOffset == nil) == (f.Anchor == nil) {
+		return errors.New("a moveable feast needs either pascha_offset or anchor")
+	}
+	if a := f.Anchor; a != nil {
+		if a.Month < 1 || a.Month > 12 {
+			return fieldError("anchor.month", "must be 1-12, got %d", a.Month)
+		}
+		if a.Day < 1 || a.Day > 31 {
+			return fieldError("anchor.day", "must be 1-31, got %d", a.Day)
+		}
+		if a.Weekday < 0 || a.Weekday > 6 {
+			return fieldError("anchor.weekday", "must be 0-6, got %d", a.Weekday)
+		}
+		switch a.Relation {
+		case models.AnchorBefore, models.AnchorAfter, models.AnchorNearest:
+		default:
+			return fieldError("anchor.relation", "must be before, after or nearest, got %q", a.Relation)
+		}
+	}
+	return nil
+}
+
+func checkSaint(s models.Saint) error {
+	if err := checkName(s.Name); err != nil {
+		return err
+	}
+	return checkMonthDay(s.Month, s.Day)
+}
+
+func checkNameDay(n models.NameDay) error {
+	if err := checkName(n.Name); err != nil {
+		return err
+	}
+	if len(n.Commemorations) == 0 {
+		return fieldError("commemorations", "needs at least one feast or saint")
+	}
+	return nil
+}
+
+// checkSpan requires a span to select some days.
+func checkSpan(s models.DateSpan) error {
+	if s.WeekdayOnly != nil {
+		if *s.WeekdayOnly < 0 || *s.WeekdayOnly > 6 {
+			return fieldError("weekday_only", "must be 0-6, got %d", *s.WeekdayOnly)
+		}
+		return nil
+	}
+	if s.PaschaOffsetStart == nil && s.FixedStartMonth == nil {
+		return errors.New("needs pascha_offset_start, fixed_start_month or weekday_only")
+	}
+	if s.FixedStartMonth != nil && (s.FixedStartDay == nil || s.FixedEndMonth == nil || s.FixedEndDay == nil) {
+		return errors.New("a fixed range needs fixed_start_month, fixed_start_day, fixed_end_month and fixed_end_day")
+	}
+	if s.PaschaOffsetStart != nil && s.PaschaOffsetEnd == nil && s.FixedEndMonth == nil {
+		return errors.New("pascha_offset_start needs pascha_offset_end or fixed_end_month")
+	}
+	return nil
+}
+
+func checkFastingRule(r models.FastingRule) error {
+	if err := checkName(r.Name); err != nil {
+		return err
+	}
+	if err := checkFastingLevel("level", r.Level); err != nil {
+		return err
+	}
+	for i, ov := range r.WeekdayOverrides {
+		if err := checkFastingLevel(fmt.Sprintf("weekday_overrides[%d].level", i), ov.Level); err != nil {
+			return err
+		}
+	}
+	for i, rx := range r.FeastRelaxations {
+		if err := checkFeastRelaxation(fmt.Sprintf("feast_relaxations[%d]", i), rx); err != nil {
+			return err
+		}
+	}
+	return checkSpan(r.DateSpan)
+}
+
+func checkFeastRelaxation(field string, rx models.FeastRelaxation) error {
+	if len(rx.Ranks) == 0 && len(rx.Kinds) == 0 {
+		return fieldError(field, "needs ranks or kinds")
+	}
+	for i, rank := range rx.Ranks {
+		if err := checkRank(rank); err != nil {
+			return fieldError(fmt.Sprintf("%s.ranks[%d]", field, i), "must be great, major or minor, got %q", rank)
+		}
+	}
+	for i, k := range rx.Kinds {
+		if err := checkKind(fmt.Sprintf("%s.kinds[%d]", field, i), k); err != nil {
+			return err
+		}
+	}
+	for i, wd := range rx.Weekdays {
+		if wd < 0 || wd > 6 {
+			return fieldError(fmt.Sprintf("%s.weekdays[%d]", field, i), "must be 0-6, got %d", wd)
+		}
+	}
+	return checkFastingLevel(field+".level", rx.Level)
+}
+
+func checkWeddingRule(r models.WeddingRule) error {
+	if err := checkName(r.Name); err != nil {
+		return err
+	}
+	if r.GreatFeastEve {
+		return nil
+	}
+	return checkSpan(r.DateSpan)
+}
+
+func checkKneeling(field string, k models.Kneeling) error {
+	switch k {
+	case models.KneelingPermitted, models.KneelingNone, models.KneelingProstrations:
+		return nil
+	}
+	return fieldError(field, "must be kneeling, none or prostrations, got %q", k)
+}
+
+func checkKneelingRule(r models.KneelingRule) error {
+	if err := checkName(r.Name); err != nil {
+		return err
+	}
+	if err := checkKneeling("level", r.Level); err != nil {
+		return err
+	}
+	for i, ov := range r.WeekdayOverrides {
+		if err := checkKneeling(fmt.Sprintf("weekday_overrides[%d].level", i), ov.Level); err != nil {
+			return err
+		}
+	}
+	return checkSpan(r.DateSpan)
+}
+
+func checkTransferRule(r models.TransferRule) error {
+	if err := checkName(r.Name); err != nil {
+		return err
+	}
+	if err := checkMonthDay(r.Month, r.Day); err != nil {
+		return err
+	}
+	switch r.Action {
+	case models.TransferMove:
+		if r.TargetPaschaOffset == nil {
+			return fieldError("target_pascha_offset", "is required for a transfer")
+		}
+	case models.TransferMerge, models.TransferCoincidence:
+	default:
+		return fieldError("action", "must be transfer, merge or coincidence, got %q", r.Action)
+	}
+	return nil
+}
+
+func checkMemorial(m models.Memorial) error {
+	return checkName(m.Name)
+}
+
+func checkQuote(q models.Quote) error {
+	if q.Text == "" {
+		return fieldError("text", "is required")
+	}
+	if q.Author == "" {
+		return fieldError("author", "is required")
+	}
+	return nil
+}
+
+func checkFoodItem(f models.FoodItem) error {
+	if err := checkName(f.Name); err != nil {
+		return err
+	}
+	if len(f.Categories) == 0 {
+		return fieldError("categories", "needs at least one food category")
+	}
+	return checkFoods("categories", f.Categories)
+}
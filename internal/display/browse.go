@@ -5,7 +5,6 @@ import (
 	"greekOrtho/internal/models"
 	"os"
 	"strings"
-	"time"
 
 	"golang.org/x/term"
 )
@@ -15,9 +14,15 @@ const (
 	clearScreen  = "\033[2J\033[H"
 )
 
+// DaySource supplies liturgical data for the dates the browser displays.
+type DaySource interface {
+	GetDayInfo(date models.Date) models.DayInfo
+	Month(date models.Date) []models.DayInfo
+}
+
 // Browse runs an interactive calendar browser starting at startDate.
-// getDayInfo is called to fetch liturgical data for any date the user navigates to.
-func Browse(getDayInfo func(time.Time) models.DayInfo, startDate time.Time) error {
+// src is queried for the month grid and the selected day as the user navigates.
+func Browse(src DaySource, startDate models.Date) error {
 	fd := int(os.Stdin.Fd())
 	oldState, err := term.MakeRaw(fd)
 	if err != nil {
@@ -25,13 +30,12 @@ func Browse(getDayInfo func(time.Time) models.DayInfo, startDate time.Time) erro
 	}
 	defer term.Restore(fd, oldState)
 
-	today := time.Now()
-	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	today := models.Today()
 	selected := startDate
 
 	buf := make([]byte, 3)
 	for {
-		screen := renderBrowseScreen(getDayInfo, selected, today)
+		screen := renderBrowseScreen(src, selected, today)
 		fmt.Fprint(os.Stdout, screen)
 
 		n, err := os.Stdin.Read(buf)
@@ -52,49 +56,49 @@ func Browse(getDayInfo func(time.Time) models.DayInfo, startDate time.Time) erro
 		case n == 3 && buf[0] == 0x1b && buf[1] == '[':
 			switch buf[2] {
 			case 'A': // up
-				selected = selected.AddDate(0, 0, -7)
+				selected = selected.AddDays(-7)
 			case 'B': // down
-				selected = selected.AddDate(0, 0, 7)
+				selected = selected.AddDays(7)
 			case 'C': // right
-				selected = selected.AddDate(0, 0, 1)
+				selected = selected.AddDays(1)
 			case 'D': // left
-				selected = selected.AddDate(0, 0, -1)
+				selected = selected.AddDays(-1)
 			}
 		}
 	}
 	return nil
 }
 
-func nextMonth(d time.Time) time.Time {
-	year, month, day := d.Date()
-	next := time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)
-	lastDay := next.AddDate(0, 1, -1).Day()
+func nextMonth(d models.Date) models.Date {
+	next := models.NewDate(d.Year, d.Month+1, 1)
+	lastDay := models.NewDate(next.Year, next.Month+1, 0).Day
+	day := d.Day
 	if day > lastDay {
 		day = lastDay
 	}
-	return time.Date(next.Year(), next.Month(), day, 0, 0, 0, 0, time.UTC)
+	return models.NewDate(next.Year, next.Month, day)
 }
 
-func prevMonth(d time.Time) time.Time {
-	year, month, day := d.Date()
-	prev := time.Date(year, month-1, 1, 0, 0, 0, 0, time.UTC)
-	lastDay := prev.AddDate(0, 1, -1).Day()
+func prevMonth(d models.Date) models.Date {
+	prev := models.NewDate(d.Year, d.Month-1, 1)
+	lastDay := models.NewDate(prev.Year, prev.Month+1, 0).Day
+	day := d.Day
 	if day > lastDay {
 		day = lastDay
 	}
-	return time.Date(prev.Year(), prev.Month(), day, 0, 0, 0, 0, time.UTC)
+	return models.NewDate(prev.Year, prev.Month, day)
 }
 
-func renderBrowseScreen(getDayInfo func(time.Time) models.DayInfo, selected, today time.Time) string {
+func renderBrowseScreen(src DaySource, selected, today models.Date) string {
 	var sb strings.Builder
 	sb.WriteString(clearScreen)
 
-	sb.WriteString(renderBrowseMonth(getDayInfo, selected, today))
+	sb.WriteString(renderBrowseMonth(src.Month(selected), selected, today))
 	sb.WriteString("\r\n")
 	sb.WriteString(dim + strings.Repeat(divHoriz, 60) + reset + "\r\n")
 	sb.WriteString("\r\n")
 
-	info := getDayInfo(selected)
+	info := src.GetDayInfo(selected)
 	sb.WriteString(renderBrowseDayInfo(info))
 
 	sb.WriteString("\r\n")
@@ -104,19 +108,17 @@ func renderBrowseScreen(getDayInfo func(time.Time) models.DayInfo, selected, tod
 	return sb.String()
 }
 
-func renderBrowseMonth(getDayInfo func(time.Time) models.DayInfo, selected, today time.Time) string {
+func renderBrowseMonth(days []models.DayInfo, selected, today models.Date) string {
 	var sb strings.Builder
 
-	year, month, _ := selected.Date()
-	firstOfMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
-	daysInMonth := firstOfMonth.AddDate(0, 1, -1).Day()
+	year, month := selected.Year, selected.Month
+	firstOfMonth := models.NewDate(year, month, 1)
+	daysInMonth := len(days)
 	startWeekday := int(firstOfMonth.Weekday())
 
-	// Pre-fetch day info for the whole month
 	dayInfos := make(map[int]models.DayInfo, daysInMonth)
-	for i := 1; i <= daysInMonth; i++ {
-		d := time.Date(year, month, i, 0, 0, 0, 0, time.UTC)
-		dayInfos[i] = getDayInfo(d)
+	for _, d := range days {
+		dayInfos[d.Date.Day] = d
 	}
 
 	title := fmt.Sprintf("☦  %s %d", month, year)
@@ -147,15 +149,12 @@ func renderBrowseMonth(getDayInfo func(time.Time) models.DayInfo, selected, toda
 			fastColor, _ := fastingStyle(info.FastingLevel)
 
 			numStr := fmt.Sprintf("%d", dayNum)
-			hasFeast := len(info.Feasts) > 0
-			if hasFeast {
-				numStr += "✦"
-			}
+			marker := dayMarker(info)
+			hasMarker := marker != ""
+			numStr += marker
 
-			isSelected := selected.Day() == dayNum
-			isToday := today.Year() == year &&
-				today.Month() == month &&
-				today.Day() == dayNum
+			isSelected := selected.Day == dayNum
+			isToday := today == models.NewDate(year, month, dayNum)
 
 			cell := ""
 			switch {
@@ -171,8 +170,8 @@ func renderBrowseMonth(getDayInfo func(time.Time) models.DayInfo, selected, toda
 
 			// Pad to cell width accounting for multi-byte ✦ (3 bytes in UTF-8)
 			visualLen := len(numStr)
-			if hasFeast {
-				visualLen = visualLen - 2 // ✦ is 3 bytes but ~1 visual char
+			if hasMarker {
+				visualLen = visualLen - 2 // ✦/✝/✧ is 3 bytes but ~1 visual char
 			}
 			padding := cellWidth - visualLen
 			if padding < 1 {
@@ -187,8 +186,8 @@ func renderBrowseMonth(getDayInfo func(time.Time) models.DayInfo, selected, toda
 	}
 
 	sb.WriteString("\r\n")
-	legend := " 🔴 Strict  🟠 Oil/Wine  🟡 Fish  🟢 No Fast  ✦ Feast"
-	sb.WriteString(legend + "\r\n")
+	sb.WriteString(" ⚫ Total  🔴 Strict  🟠 Oil/Wine  🟡 Fish  🟢 No Fast\r\n")
+	sb.WriteString(" ✦ Feast  ✧ Fore/Afterfeast  ✝ Souls\r\n")
 
 	return sb.String()
 }
@@ -197,10 +196,19 @@ func renderBrowseDayInfo(info models.DayInfo) string {
 	var sb strings.Builder
 
 	sb.WriteString(" " + boldWhite + info.Date.Format("Monday, January 2, 2006") + reset + "\r\n")
+	if info.Style == models.StyleJulian {
+		sb.WriteString(" " + dimWhite + dualDate(info) + reset + "\r\n")
+	}
+	if info.LiturgicalDay.Title != "" {
+		sb.WriteString(" " + white + info.LiturgicalDay.Title + reset + "\r\n")
+	}
+	if t := toneLine(info); t != "" {
+		sb.WriteString(" " + dimWhite + t + reset + "\r\n")
+	}
 	sb.WriteString("\r\n")
 
 	// Feasts
-	if len(info.Feasts) > 0 {
+	if len(info.Feasts) > 0 || len(info.FeastPeriods) > 0 {
 		for _, f := range info.Feasts {
 			sb.WriteString(" " + boldGold + "✦ " + f.Name + reset + "\r\n")
 			if f.Rank != "" {
@@ -209,6 +217,26 @@ func renderBrowseDayInfo(info models.DayInfo) string {
 			if f.GreekName != "" {
 				sb.WriteString("   " + dimWhite + f.GreekName + reset + "\r\n")
 			}
+			if f.TransferredFrom != nil {
+				sb.WriteString("   " + dimWhite + "Transferred from " + transferredFrom(info, f) + reset + "\r\n")
+			}
+		}
+		for _, p := range info.FeastPeriods {
+			sb.WriteString(" " + yellow + "✧ " + p.Title() + reset + "\r\n")
+		}
+		for _, note := range info.Coincidences {
+			sb.WriteString(" " + yellow + "❖ " + note + reset + "\r\n")
+		}
+		sb.WriteString("\r\n")
+	}
+
+	// Saturdays of Souls
+	if len(info.Memorials) > 0 {
+		for _, m := range info.Memorials {
+			sb.WriteString(" " + boldWhite + "✝ " + m.Name + reset + "\r\n")
+			if m.GreekName != "" {
+				sb.WriteString("   " + dimWhite + m.GreekName + reset + "\r\n")
+			}
 		}
 		sb.WriteString("\r\n")
 	}
@@ -226,19 +254,66 @@ func renderBrowseDayInfo(info models.DayInfo) string {
 		sb.WriteString("\r\n")
 	}
 
+	// Name days
+	if len(info.NameDays) > 0 {
+		sb.WriteString(" " + boldCyan + "Name Days" + reset + "\r\n")
+		for _, n := range info.NameDays {
+			sb.WriteString("   " + cyan + "• " + nameDayLabel(n) + reset + "\r\n")
+		}
+		sb.WriteString("\r\n")
+	}
+
+	// Contacts celebrating
+	if len(info.Contacts) > 0 {
+		sb.WriteString(" " + boldCyan + "Contacts Celebrating" + reset + "\r\n")
+		for _, ct := range info.Contacts {
+			sb.WriteString("   " + white + "• " + contactLabel(ct) + reset + "\r\n")
+		}
+		sb.WriteString("\r\n")
+	}
+
 	// Fasting
 	fastColor, fastIcon := fastingStyle(info.FastingLevel)
 	sb.WriteString(" " + bold + fastIcon + " Fasting" + reset + "\r\n")
 	sb.WriteString("   " + fastColor + FastingDescription(info.FastingLevel) + reset + "\r\n")
+	if p := permittedLine(info.FastingLevel); p != "" {
+		sb.WriteString("   " + dimWhite + p + reset + "\r\n")
+	}
 	if info.FastingReason != "" {
 		sb.WriteString("   " + dimWhite + info.FastingReason + reset + "\r\n")
 	}
+	if c := fastCountdown(info.FastingStatus, info.Date); c != "" {
+		sb.WriteString("   " + cyan + "⏳ " + c + reset + "\r\n")
+	}
+	if pf := info.PersonalFast; pf != nil {
+		color, _ := personalFastStyle(*pf)
+		sb.WriteString("   " + bold + "Your fast: " + reset + color + personalFastDescription(*pf) + reset + "\r\n")
+		if pf.Reason != "" {
+			sb.WriteString("   " + dimWhite + pf.Reason + reset + "\r\n")
+		}
+	}
 	sb.WriteString("\r\n")
 
+	// Divine Liturgy
+	if info.Liturgy != "" {
+		sb.WriteString(" " + bold + "⛪ " + LiturgyDescription(info.Liturgy) + reset + "\r\n")
+		if info.LiturgyNote != "" {
+			sb.WriteString("   " + dimWhite + info.LiturgyNote + reset + "\r\n")
+		}
+		if k := kneelingLine(info); k != "" {
+			sb.WriteString("   " + white + k + reset + "\r\n")
+		}
+		sb.WriteString("\r\n")
+	}
+
 	// Scripture Readings
 	if len(info.Readings) > 0 {
 		sb.WriteString(" " + bold + "📖 Scripture Readings" + reset + "\r\n")
 		for _, r := range info.Readings {
+			if r.Source == "Matins" {
+				sb.WriteString("   " + blue + "Matins:  " + r.Gospel.Book + " " + r.Gospel.Passage + reset + "\r\n")
+				continue
+			}
 			if r.Epistle != nil {
 				sb.WriteString("   " + blue + "Epistle: " + r.Epistle.Book + " " + r.Epistle.Passage + reset + "\r\n")
 			}
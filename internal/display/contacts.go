@@ -0,0 +1,67 @@
+package display
+
+import (
+	"fmt"
+	"greekOrtho/internal/models"
+)
+
+// contactLabel returns a contact with the name day they keep, e.g.
+// "Giorgos Papadopoulos (George)".
+func contactLabel(c models.Contact) string {
+	return c.Name + " (" + c.NameDay + ")"
+}
+
+// PrintUpcoming lists the contacts celebrating their name day on each of the
+// given days. unmatched is the number of contacts with no known name day.
+func PrintUpcoming(days []models.DayInfo, unmatched int) {
+	if len(days) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(topBorder())
+
+	// Header
+	fmt.Println(emptyLine())
+	first, last := days[0].Date, days[len(days)-1].Date
+	title := fmt.Sprintf("☦  Name Days — %s to %s", first.Format("Jan 2"), last.Format("Jan 2, 2006"))
+	fmt.Println(line(boldGold + "  " + title + reset))
+	if days[0].Style == models.StyleJulian {
+		fmt.Println(line(dimWhite + "  Old Calendar" + reset))
+	}
+	fmt.Println(emptyLine())
+	fmt.Println(divider())
+	fmt.Println(emptyLine())
+
+	count := 0
+	for _, d := range days {
+		if len(d.Contacts) == 0 {
+			continue
+		}
+		count += len(d.Contacts)
+
+		date := d.Date.Format("Mon Jan 2")
+		if d.Style == models.StyleJulian {
+			date += " (" + shortJulian(d.JulianDate) + " O.S.)"
+		}
+		fmt.Println(line(boldWhite + "  " + date + reset))
+		for _, c := range d.Contacts {
+			fmt.Println(line(cyan + "    • " + contactLabel(c) + reset))
+		}
+	}
+	if count == 0 {
+		fmt.Println(line(dimWhite + "  No contacts celebrate in this period" + reset))
+	}
+	fmt.Println(emptyLine())
+
+	if unmatched > 0 {
+		fmt.Println(divider())
+		noun := "contacts"
+		if unmatched == 1 {
+			noun = "contact"
+		}
+		fmt.Println(line(dimWhite + fmt.Sprintf("  %d %s without a known name day", unmatched, noun) + reset))
+	}
+	fmt.Println(bottomBorder())
+	fmt.Println()
+}
@@ -64,8 +64,14 @@ func emptyLine() string {
 // FastingDescription returns a human-readable description of the fasting level.
 func FastingDescription(level models.FastingLevel) string {
 	switch level {
+	case models.FastingTotal:
+		return "Total Abstinence (no food, by custom)"
+	case models.FastingXerophagy:
+		return "Xerophagy (bread, raw vegetables and fruit only)"
 	case models.FastingStrict:
 		return "Strict Fast (no meat, dairy, fish, oil, or wine)"
+	case models.FastingWine:
+		return "Wine Permitted (no oil, meat, dairy, or fish)"
 	case models.FastingOilWine:
 		return "Oil and Wine Permitted (no meat, dairy, or fish)"
 	case models.FastingFish:
@@ -79,6 +85,76 @@ func FastingDescription(level models.FastingLevel) string {
 	}
 }
 
+// permittedLine lists the foods a fasting level permits beyond dry and cooked
+// food, e.g. "Permitted: shellfish, wine, oil", or "" when there are none.
+func permittedLine(level models.FastingLevel) string {
+	foods, ok := level.Permits()
+	if !ok || level == models.FastingNone {
+		return ""
+	}
+	return foodsLine(foods)
+}
+
+// foodsLine lists foods beyond dry and cooked food as "Permitted: ...", or
+// returns "" when there are none.
+func foodsLine(foods models.FoodSet) string {
+	var names []string
+	for _, f := range foods.Foods() {
+		if f != models.FoodDry && f != models.FoodCooked {
+			names = append(names, f.Name())
+		}
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	return "Permitted: " + strings.Join(names, ", ")
+}
+
+// personalFastStyle returns the color and icon of a personal fast: those of
+// its level, or of the strictest level permitting all its foods.
+func personalFastStyle(pf models.PersonalFast) (string, string) {
+	if pf.Level != "" {
+		return fastingStyle(pf.Level)
+	}
+	for _, l := range models.FastingLevels {
+		if foods, _ := l.Permits(); pf.Foods.SubsetOf(foods) {
+			return fastingStyle(l)
+		}
+	}
+	return fastingStyle(models.FastingNone)
+}
+
+// personalFastDescription describes a personal fast: as the canonical one when
+// it does not differ, by its named level, or else by the foods it permits.
+func personalFastDescription(pf models.PersonalFast) string {
+	switch {
+	case pf.Reason == "":
+		return "As the Church's fast"
+	case pf.Level != "":
+		return FastingDescription(pf.Level)
+	}
+	if l := foodsLine(pf.Foods); l != "" {
+		return l
+	}
+	return "Dry food only"
+}
+
+// LiturgyDescription returns a human-readable name of the Liturgy.
+func LiturgyDescription(l models.Liturgy) string {
+	switch l {
+	case models.LiturgyChrysostom:
+		return "Divine Liturgy of St. John Chrysostom"
+	case models.LiturgyBasil:
+		return "Divine Liturgy of St. Basil the Great"
+	case models.LiturgyPresanctified:
+		return "Liturgy of the Presanctified Gifts"
+	case models.LiturgyNone:
+		return "No Divine Liturgy"
+	default:
+		return "Unknown"
+	}
+}
+
 // PrintDayInfo formats and prints the day's liturgical information.
 func PrintDayInfo(info models.DayInfo) {
 	fmt.Println()
@@ -88,10 +164,19 @@ func PrintDayInfo(info models.DayInfo) {
 	fmt.Println(emptyLine())
 	fmt.Println(line(boldGold + "  ☦  Greek Orthodox Calendar" + reset))
 	fmt.Println(line(boldWhite + "  " + info.Date.Format("Monday, January 2, 2006") + reset))
+	if info.Style == models.StyleJulian {
+		fmt.Println(line(dimWhite + "  " + dualDate(info) + reset))
+	}
+	if info.LiturgicalDay.Title != "" {
+		fmt.Println(line(white + "  " + info.LiturgicalDay.Title + reset))
+	}
+	if t := toneLine(info); t != "" {
+		fmt.Println(line(dimWhite + "  " + t + reset))
+	}
 	fmt.Println(emptyLine())
 
 	// Feasts
-	if len(info.Feasts) > 0 {
+	if len(info.Feasts) > 0 || len(info.FeastPeriods) > 0 {
 		fmt.Println(divider())
 		fmt.Println(emptyLine())
 		for i, f := range info.Feasts {
@@ -102,10 +187,39 @@ func PrintDayInfo(info models.DayInfo) {
 			if f.GreekName != "" {
 				fmt.Println(line(dimWhite + "    " + f.GreekName + reset))
 			}
+			if f.TransferredFrom != nil {
+				fmt.Println(line(dimWhite + "    Transferred from " + transferredFrom(info, f) + reset))
+			}
 			if i < len(info.Feasts)-1 {
 				fmt.Println(emptyLine())
 			}
 		}
+		for i, p := range info.FeastPeriods {
+			if i == 0 && len(info.Feasts) > 0 {
+				fmt.Println(emptyLine())
+			}
+			fmt.Println(line(yellow + "  ✧ " + p.Title() + reset))
+		}
+		for _, note := range info.Coincidences {
+			fmt.Println(emptyLine())
+			fmt.Println(line(yellow + "  ❖ " + note + reset))
+		}
+		fmt.Println(emptyLine())
+	}
+
+	// Saturdays of Souls
+	if len(info.Memorials) > 0 {
+		fmt.Println(divider())
+		fmt.Println(emptyLine())
+		for _, m := range info.Memorials {
+			fmt.Println(line(boldWhite + "  ✝ " + m.Name + reset))
+			if m.GreekName != "" {
+				fmt.Println(line(dimWhite + "    " + m.GreekName + reset))
+			}
+			if m.Description != "" {
+				fmt.Println(line(dimWhite + "    " + m.Description + reset))
+			}
+		}
 		fmt.Println(emptyLine())
 	}
 
@@ -124,23 +238,78 @@ func PrintDayInfo(info models.DayInfo) {
 		fmt.Println(emptyLine())
 	}
 
+	// Name days
+	if len(info.NameDays) > 0 {
+		fmt.Println(divider())
+		fmt.Println(emptyLine())
+		fmt.Println(line(boldCyan + "  Name Days" + reset))
+		for _, n := range info.NameDays {
+			fmt.Println(line(cyan + "    • " + nameDayLabel(n) + reset))
+		}
+		fmt.Println(emptyLine())
+	}
+
+	// Contacts celebrating
+	if len(info.Contacts) > 0 {
+		fmt.Println(divider())
+		fmt.Println(emptyLine())
+		fmt.Println(line(boldCyan + "  Contacts Celebrating" + reset))
+		for _, ct := range info.Contacts {
+			fmt.Println(line(white + "    • " + contactLabel(ct) + reset))
+		}
+		fmt.Println(emptyLine())
+	}
+
 	// Fasting
 	fmt.Println(divider())
 	fmt.Println(emptyLine())
 	fastColor, fastIcon := fastingStyle(info.FastingLevel)
 	fmt.Println(line(bold + "  " + fastIcon + " Fasting" + reset))
 	fmt.Println(line(fastColor + "    " + FastingDescription(info.FastingLevel) + reset))
+	if p := permittedLine(info.FastingLevel); p != "" {
+		fmt.Println(line(dimWhite + "    " + p + reset))
+	}
 	if info.FastingReason != "" {
 		fmt.Println(line(dimWhite + "    " + info.FastingReason + reset))
 	}
+	if c := fastCountdown(info.FastingStatus, info.Date); c != "" {
+		fmt.Println(line(cyan + "    ⏳ " + c + reset))
+	}
+	if pf := info.PersonalFast; pf != nil {
+		fmt.Println(emptyLine())
+		color, icon := personalFastStyle(*pf)
+		fmt.Println(line(bold + "  " + icon + " Your Fast" + reset))
+		fmt.Println(line(color + "    " + personalFastDescription(*pf) + reset))
+		for _, l := range wrapWords(strings.Fields(pf.Reason), contentWidth-4) {
+			fmt.Println(line(dimWhite + "    " + l + reset))
+		}
+	}
 	fmt.Println(emptyLine())
 
+	// Divine Liturgy
+	if info.Liturgy != "" {
+		fmt.Println(divider())
+		fmt.Println(emptyLine())
+		fmt.Println(line(bold + "  ⛪ " + LiturgyDescription(info.Liturgy) + reset))
+		if info.LiturgyNote != "" {
+			fmt.Println(line(dimWhite + "    " + info.LiturgyNote + reset))
+		}
+		if k := kneelingLine(info); k != "" {
+			fmt.Println(line(white + "    " + k + reset))
+		}
+		fmt.Println(emptyLine())
+	}
+
 	// Scripture Readings
 	if len(info.Readings) > 0 {
 		fmt.Println(divider())
 		fmt.Println(emptyLine())
 		fmt.Println(line(bold + "  📖 Scripture Readings" + reset))
 		for _, r := range info.Readings {
+			if r.Source == "Matins" {
+				fmt.Println(line(blue + "    Matins:  " + r.Gospel.Book + " " + r.Gospel.Passage + reset))
+				continue
+			}
 			if r.Epistle != nil {
 				fmt.Println(line(blue + "    Epistle: " + r.Epistle.Book + " " + r.Epistle.Passage + reset))
 			}
@@ -163,9 +332,11 @@ func PrintDayInfo(info models.DayInfo) {
 
 func fastingStyle(level models.FastingLevel) (string, string) {
 	switch level {
-	case models.FastingStrict:
+	case models.FastingTotal:
+		return boldRed, "⚫"
+	case models.FastingXerophagy, models.FastingStrict:
 		return boldRed, "🔴"
-	case models.FastingOilWine:
+	case models.FastingWine, models.FastingOilWine:
 		return red, "🟠"
 	case models.FastingFish:
 		return yellow, "🟡"
@@ -178,6 +349,63 @@ func fastingStyle(level models.FastingLevel) (string, string) {
 	}
 }
 
+// KneelingDescription returns a human-readable description of a kneeling rule.
+func KneelingDescription(k models.Kneeling) string {
+	switch k {
+	case models.KneelingPermitted:
+		return "Kneeling"
+	case models.KneelingNone:
+		return "No kneeling"
+	case models.KneelingProstrations:
+		return "Prostrations"
+	default:
+		return string(k)
+	}
+}
+
+// kneelingLine returns the day's kneeling rule with its reason, e.g.
+// "No kneeling — Sunday, the day of the Resurrection", or "" if unknown.
+func kneelingLine(info models.DayInfo) string {
+	if info.Kneeling == "" {
+		return ""
+	}
+	if info.KneelingReason == "" {
+		return KneelingDescription(info.Kneeling)
+	}
+	return KneelingDescription(info.Kneeling) + " — " + info.KneelingReason
+}
+
+// toneLine returns the tone and Eothinon of the day, e.g. "Grave Tone · Eothinon 5",
+// or "" in Holy Week.
+func toneLine(info models.DayInfo) string {
+	if info.Tone == 0 {
+		return ""
+	}
+	if info.Eothinon > 0 {
+		return fmt.Sprintf("%s · Eothinon %d", info.Tone, info.Eothinon)
+	}
+	return info.Tone.String()
+}
+
+// dualDate returns the Julian and civil dates side by side, e.g. "Dec 25 O.S. / Jan 7 N.S.".
+func dualDate(info models.DayInfo) string {
+	return shortJulian(info.JulianDate) + " O.S. / " + info.Date.Format("Jan 2") + " N.S."
+}
+
+// transferredFrom returns the date a transferred feast was moved from, e.g. "Apr 23".
+func transferredFrom(info models.DayInfo, f models.Feast) string {
+	if info.Style == models.StyleJulian {
+		return shortJulian(f.TransferredFrom.Julian()) + " O.S."
+	}
+	return f.TransferredFrom.Format("Jan 2")
+}
+
+// shortJulian formats a Julian date as "Jan 2". It reads the fields directly, since
+// Julian dates such as Feb 29, 2100 do not exist on the Gregorian calendar.
+func shortJulian(d models.Date) string {
+	return fmt.Sprintf("%.3s %d", d.Month, d.Day)
+}
+
 func rankDisplay(r models.FeastRank) string {
 	switch r {
 	case models.RankGreat:
@@ -222,8 +450,14 @@ func printWrappedQuote(q models.Quote) {
 // shortFastingLabel returns a short plain-text label for the fasting level.
 func shortFastingLabel(level models.FastingLevel) string {
 	switch level {
+	case models.FastingTotal:
+		return "Total Fast"
+	case models.FastingXerophagy:
+		return "Xerophagy"
 	case models.FastingStrict:
 		return "Strict"
+	case models.FastingWine:
+		return "Wine"
 	case models.FastingOilWine:
 		return "Oil & Wine"
 	case models.FastingFish:
@@ -238,26 +472,46 @@ func shortFastingLabel(level models.FastingLevel) string {
 }
 
 // PrintSimple prints a one-liner summary suitable for piping, shell prompts, or status bars.
-// Format: Thu Feb 5 | 🟠 Oil & Wine | St. Agatha | Lk 6:17-23
-func PrintSimple(info models.DayInfo) {
+// Format: Thu Feb 5 | 🟠 Oil & Wine | ⏳ Cheesefare Week in 11d | St. Agatha | Tone pl. 2 | Lk 6:17-23
+// In Julian style the date carries the Old Style date: Thu Feb 18 (Feb 5 O.S.)
+// With showTitle the liturgical day follows the date: Sun Oct 18 · 4th Sunday of Luke
+func PrintSimple(info models.DayInfo, showTitle bool) {
 	_, icon := fastingStyle(info.FastingLevel)
 	label := shortFastingLabel(info.FastingLevel)
 
+	dateStr := info.Date.Format("Mon Jan 2")
+	if info.Style == models.StyleJulian {
+		dateStr += " (" + shortJulian(info.JulianDate) + " O.S.)"
+	}
+	if showTitle && info.LiturgicalDay.Title != "" {
+		dateStr += " · " + info.LiturgicalDay.Title
+	}
+
 	parts := []string{
-		info.Date.Format("Mon Jan 2"),
+		dateStr,
 		icon + " " + label,
 	}
+	if c := shortFastCountdown(info.FastingStatus, info.Date); c != "" {
+		parts = append(parts, "⏳ "+c)
+	}
 
-	if len(info.Feasts) > 0 {
-		names := make([]string, len(info.Feasts))
-		for i, f := range info.Feasts {
-			names[i] = "✦ " + f.Name
-		}
+	var names []string
+	for _, f := range info.Feasts {
+		names = append(names, "✦ "+f.Name)
+	}
+	for _, m := range info.Memorials {
+		names = append(names, "✝ "+m.Name)
+	}
+	if len(names) > 0 {
 		parts = append(parts, strings.Join(names, ", "))
 	} else if len(info.Saints) > 0 {
 		parts = append(parts, info.Saints[0].Name)
 	}
 
+	if info.Tone != 0 {
+		parts = append(parts, info.Tone.Short())
+	}
+
 	// Append gospel citation
 	if len(info.Readings) > 0 && info.Readings[0].Gospel != nil {
 		g := info.Readings[0].Gospel
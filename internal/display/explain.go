@@ -0,0 +1,91 @@
+package display
+
+import (
+	"fmt"
+	"greekOrtho/internal/models"
+	"strings"
+)
+
+// PrintFastingExplanation shows how the fasting level of a day was resolved:
+// the matching rules by priority, the weekday override, and the feast
+// relaxations and overrides.
+func PrintFastingExplanation(info models.DayInfo, e models.FastingExplanation) {
+	fmt.Println()
+	fmt.Println(topBorder())
+
+	// Header
+	fmt.Println(emptyLine())
+	fmt.Println(line(boldGold + "  ☦  Fasting Explained — " + info.Date.Format("Mon Jan 2, 2006") + reset))
+	if info.Style == models.StyleJulian {
+		fmt.Println(line(dimWhite + "  " + dualDate(info) + reset))
+	}
+	fmt.Println(emptyLine())
+	color, icon := fastingStyle(e.Level)
+	fmt.Println(line("  " + icon + " " + color + FastingDescription(e.Level) + reset))
+	if p := permittedLine(e.Level); p != "" {
+		fmt.Println(line(dimWhite + "     " + p + reset))
+	}
+	printExplainText(dimWhite, "     ", e.Reason)
+	fmt.Println(emptyLine())
+
+	// Rules
+	fmt.Println(divider())
+	fmt.Println(emptyLine())
+	fmt.Println(line(boldCyan + "  Matching Rules" + reset))
+	if len(e.Candidates) == 0 {
+		fmt.Println(line(dimWhite + "    None — no fasting rule covers the day" + reset))
+	}
+	for _, c := range e.Candidates {
+		mark, col := "✗", dimWhite
+		if c.Chosen {
+			mark, col = "✓", green
+		}
+		printExplainText(col, "    "+mark+" ", fmt.Sprintf("%s (priority %d, %s)", c.Rule.Name, c.Rule.Priority, c.Rule.Level))
+	}
+	fmt.Println(emptyLine())
+
+	// Weekday override
+	fmt.Println(line(boldCyan + "  Weekday Override" + reset))
+	if ov := e.WeekdayOverride; ov != nil {
+		fmt.Println(line(green + fmt.Sprintf("    ✓ %s → %s", ov.Weekday, ov.Level) + reset))
+	} else {
+		fmt.Println(line(dimWhite + "    None" + reset))
+	}
+	fmt.Println(emptyLine())
+
+	printDecisions("Feast Relaxations", e.Relaxations)
+	printDecisions("Feast Overrides", e.FeastOverrides)
+
+	fmt.Println(bottomBorder())
+	fmt.Println()
+}
+
+// printDecisions prints a titled list of feast relaxations or overrides,
+// marking those applied.
+func printDecisions(title string, decisions []models.FeastOverrideDecision) {
+	fmt.Println(line(boldCyan + "  " + title + reset))
+	if len(decisions) == 0 {
+		fmt.Println(line(dimWhite + "    None" + reset))
+	}
+	for _, d := range decisions {
+		mark, col := "✗", dimWhite
+		if d.Accepted {
+			mark, col = "✓", green
+		}
+		printExplainText(col, "    "+mark+" ", fmt.Sprintf("%s: %s — %s", d.Feast, d.Level, d.Note))
+	}
+	fmt.Println(emptyLine())
+}
+
+// printExplainText prints text wrapped to the box, the first line after
+// prefix and the rest indented to match.
+func printExplainText(color, prefix, text string) {
+	indent := strings.Repeat(" ", len([]rune(prefix)))
+	for i, l := range wrapWords(strings.Fields(text), contentWidth-len([]rune(prefix))) {
+		p := indent
+		if i == 0 {
+			p = prefix
+		}
+		fmt.Println(line(color + p + l + reset))
+	}
+}
@@ -0,0 +1,101 @@
+package display
+
+import (
+	"fmt"
+	"greekOrtho/internal/models"
+)
+
+// fastCountdown describes where date stands in the fasting periods, e.g.
+// "Great Lent: day 8 of 42, ends Sun Apr 5" or "Dormition Fast in 17 days
+// (Sat Aug 1)"; "" when no period is current or ahead.
+func fastCountdown(s models.FastingStatus, date models.Date) string {
+	if p := s.Current; p != nil {
+		return p.Name + ": " + periodProgress(*p, date)
+	}
+	if p := s.Next; p != nil {
+		return p.Name + " " + inDays(p.Start.Sub(date)) + " (" + p.Start.Format("Mon Jan 2") + ")"
+	}
+	return ""
+}
+
+// periodProgress tells how far into a period date is, e.g. "day 8 of 42,
+// ends Sun Apr 5".
+func periodProgress(p models.FastingPeriod, date models.Date) string {
+	if date == p.End {
+		return fmt.Sprintf("last day (%d of %d)", p.Days(), p.Days())
+	}
+	return fmt.Sprintf("day %d of %d, ends %s", date.Sub(p.Start)+1, p.Days(), p.End.Format("Mon Jan 2"))
+}
+
+// shortFastCountdown is the -simple form of fastCountdown, e.g.
+// "Great Lent 8/42" or "Dormition Fast in 17d".
+func shortFastCountdown(s models.FastingStatus, date models.Date) string {
+	if p := s.Current; p != nil {
+		return fmt.Sprintf("%s %d/%d", p.Name, date.Sub(p.Start)+1, p.Days())
+	}
+	if p := s.Next; p != nil {
+		return fmt.Sprintf("%s in %dd", p.Name, p.Start.Sub(date))
+	}
+	return ""
+}
+
+// inDays phrases a number of days ahead, e.g. "tomorrow" or "in 17 days".
+func inDays(n int) string {
+	if n == 1 {
+		return "tomorrow"
+	}
+	return fmt.Sprintf("in %d days", n)
+}
+
+// PrintFasts shows the fasting period date is in, the next period and the
+// next fast-free day, then the fasting periods of the year.
+func PrintFasts(date models.Date, style models.CalendarStyle, s models.FastingStatus, periods []models.FastingPeriod) {
+	fmt.Println()
+	fmt.Println(topBorder())
+
+	// Header
+	fmt.Println(emptyLine())
+	fmt.Println(line(boldGold + "  ☦  Fasts — " + date.Format("Mon Jan 2, 2006") + reset))
+	if style == models.StyleJulian {
+		fmt.Println(line(dimWhite + "  Old Calendar" + reset))
+	}
+	fmt.Println(emptyLine())
+
+	// Status
+	fmt.Println(divider())
+	fmt.Println(emptyLine())
+	if p := s.Current; p != nil {
+		color, icon := fastingStyle(p.Level)
+		fmt.Println(line(bold + "  " + icon + " " + p.Name + reset))
+		fmt.Println(line(color + "    " + periodProgress(*p, date) + reset))
+	} else {
+		fmt.Println(line(dimWhite + "  Not in a fasting period" + reset))
+	}
+	if p := s.Next; p != nil {
+		printExplainText(white, "  Next: ", fmt.Sprintf("%s, %s (%s)", p.Name, p.Start.Format("Mon Jan 2"), inDays(p.Start.Sub(date))))
+	}
+	if s.NextFastFree != (models.Date{}) {
+		printExplainText(green, "  Fast-free: ", fmt.Sprintf("%s (%s)", s.NextFastFree.Format("Mon Jan 2"), inDays(s.NextFastFree.Sub(date))))
+	}
+	fmt.Println(emptyLine())
+
+	// The year's periods
+	fmt.Println(divider())
+	fmt.Println(emptyLine())
+	fmt.Println(line(boldCyan + fmt.Sprintf("  Fasting Periods of %d", date.Year) + reset))
+	for _, p := range periods {
+		color, icon := fastingStyle(p.Level)
+		if s.Current != nil && p.Name == s.Current.Name && p.Start == s.Current.Start {
+			color = boldWhite
+		}
+		span := p.Start.Format("Jan 2") + " – " + p.End.Format("Jan 2")
+		if p.End.Year != p.Start.Year {
+			span += p.End.Format(", 2006")
+		}
+		fmt.Println(line("  " + icon + " " + color + fmt.Sprintf("%-16s", p.Name) + reset + white + span + dimWhite + fmt.Sprintf(" (%d days)", p.Days()) + reset))
+	}
+	fmt.Println(emptyLine())
+
+	fmt.Println(bottomBorder())
+	fmt.Println()
+}
@@ -0,0 +1,104 @@
+package display
+
+import (
+	"fmt"
+	"greekOrtho/internal/models"
+	"strings"
+)
+
+// foodItemLabel returns a food's name with its Greek form, e.g. "cheese (τυρί)".
+func foodItemLabel(f models.FoodItem) string {
+	if f.GreekName == "" {
+		return f.Name
+	}
+	return f.Name + " (" + f.GreekName + ")"
+}
+
+// foodNames lists foods by name, e.g. "dairy, eggs".
+func foodNames(foods []models.Food) string {
+	var names []string
+	for _, f := range foods {
+		names = append(names, f.Name())
+	}
+	return strings.Join(names, ", ")
+}
+
+// PrintFoodVerdict answers whether a food may be eaten on the verdict's day,
+// with the fast and the rule deciding it, then lists the days from the
+// verdict's day to end on which it may be eaten.
+func PrintFoodVerdict(v models.FoodVerdict, style models.CalendarStyle, days []models.Date, end models.Date) {
+	fmt.Println()
+	fmt.Println(topBorder())
+
+	// Header
+	fmt.Println(emptyLine())
+	fmt.Println(line(boldGold + "  ☦  " + v.Date.Format("Mon Jan 2, 2006") + reset))
+	if style == models.StyleJulian {
+		fmt.Println(line(dimWhite + "  " + shortJulian(v.Date.Julian()) + " O.S." + reset))
+	}
+	printExplainText(boldWhite, "  Can I eat ", foodItemLabel(v.Item)+"?")
+	printExplainText(dimWhite, "  Counts as: ", foodNames(v.Item.Categories))
+	if v.Item.Note != "" {
+		printExplainText(dimWhite, "  ", v.Item.Note)
+	}
+	fmt.Println(emptyLine())
+
+	// Verdict
+	fmt.Println(divider())
+	fmt.Println(emptyLine())
+	if v.Permitted {
+		fmt.Println(line(green + "  ✓ Yes, it is permitted" + reset))
+	} else {
+		printExplainText(boldRed, "  ✗ No — not permitted: ", foodNames(v.Forbidden))
+	}
+	fmt.Println(emptyLine())
+
+	color, icon := fastingStyle(v.Level)
+	fmt.Println(line("  " + icon + " " + color + FastingDescription(v.Level) + reset))
+	if v.Rule != "" {
+		printExplainText(cyan, "     Decided by: ", v.Rule)
+	}
+	printExplainText(dimWhite, "     ", v.Reason)
+	if pf := v.Personal; pf != nil {
+		color, icon := personalFastStyle(*pf)
+		fmt.Println(emptyLine())
+		fmt.Println(line(boldCyan + "  Your Fast" + reset))
+		printExplainText(color, "  "+icon+" ", personalFastDescription(*pf))
+		printExplainText(dimWhite, "     ", pf.Reason)
+	}
+	fmt.Println(emptyLine())
+
+	// Upcoming days
+	fmt.Println(divider())
+	fmt.Println(emptyLine())
+	fmt.Println(line(boldCyan + "  Permitted Days to " + end.Format("Jan 2, 2006") + reset))
+	if len(days) == 0 {
+		fmt.Println(line(dimWhite + "    No day in this period" + reset))
+	}
+	for _, r := range dateRuns(days) {
+		fmt.Println(line(white + "    • " + r + reset))
+	}
+	fmt.Println(emptyLine())
+
+	fmt.Println(bottomBorder())
+	fmt.Println()
+}
+
+// dateRuns groups sorted dates into runs of consecutive days, e.g.
+// "Mon Mar 8 – Sun Mar 14" or "Wed Mar 17".
+func dateRuns(days []models.Date) []string {
+	var runs []string
+	for i := 0; i < len(days); {
+		j := i
+		for j+1 < len(days) && days[j+1] == days[j].AddDays(1) {
+			j++
+		}
+		run := days[i].Format("Mon Jan 2")
+		if j > i {
+			run += " – " + days[j].Format("Mon Jan 2")
+		}
+		runs = append(runs, run)
+		i = j + 1
+	}
+	return runs
+}
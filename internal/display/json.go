@@ -0,0 +1,250 @@
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"greekOrtho/internal/models"
+	"os"
+)
+
+// dayJSON is the -json form of a day.
+type dayJSON struct {
+	Date       string               `json:"date"`
+	JulianDate string               `json:"julian_date"`
+	Calendar   models.CalendarStyle `json:"calendar"`
+	Title      string               `json:"title,omitempty"`
+	Feasts     []feastJSON          `json:"feasts"`
+	Saints     []string             `json:"saints"`
+	NameDays   []string             `json:"name_days"`
+	Contacts   []contactJSON        `json:"contacts"`
+	Fasting    fastingJSON          `json:"fasting"`
+	Liturgy    models.Liturgy       `json:"liturgy"`
+	Weddings   bool                 `json:"weddings"`
+	Kneeling   models.Kneeling      `json:"kneeling"`
+	Tone       int                  `json:"tone,omitempty"`
+	Readings   []models.DayReadings `json:"readings"`
+}
+
+type feastJSON struct {
+	Name      string           `json:"name"`
+	GreekName string           `json:"greek_name,omitempty"`
+	Rank      models.FeastRank `json:"rank"`
+}
+
+type contactJSON struct {
+	Name    string `json:"name"`
+	NameDay string `json:"name_day"`
+}
+
+type fastingJSON struct {
+	Level        models.FastingLevel `json:"level"`
+	Permitted    []models.Food       `json:"permitted"`
+	Reason       string              `json:"reason,omitempty"`
+	Personal     *personalFastJSON   `json:"personal,omitempty"`
+	Period       *periodJSON         `json:"period,omitempty"`
+	NextPeriod   *periodJSON         `json:"next_period,omitempty"`
+	NextFastFree string              `json:"next_fast_free,omitempty"`
+}
+
+// periodJSON is a fasting period; Day counts from 1 on the day shown, if it
+// falls in the period.
+type periodJSON struct {
+	Name  string              `json:"name"`
+	Level models.FastingLevel `json:"level"`
+	Start string              `json:"start"`
+	End   string              `json:"end"`
+	Days  int                 `json:"days"`
+	Day   int                 `json:"day,omitempty"`
+}
+
+type personalFastJSON struct {
+	Level     models.FastingLevel `json:"level,omitempty"`
+	Permitted []models.Food       `json:"permitted"`
+	Reason    string              `json:"reason,omitempty"`
+}
+
+// newDayJSON converts a day to its -json form. Lists are never null.
+func newDayJSON(info models.DayInfo) dayJSON {
+	d := dayJSON{
+		Date:       info.Date.String(),
+		JulianDate: info.JulianDate.String(),
+		Calendar:   info.Style,
+		Title:      info.LiturgicalDay.Title,
+		Feasts:     []feastJSON{},
+		Saints:     []string{},
+		NameDays:   []string{},
+		Contacts:   contactsJSON(info.Contacts),
+		Fasting:    fastingJSON{Level: info.FastingLevel, Permitted: permittedFoods(info.FastingLevel), Reason: info.FastingReason},
+		Liturgy:    info.Liturgy,
+		Weddings:   info.Weddings,
+		Kneeling:   info.Kneeling,
+		Tone:       int(info.Tone),
+		Readings:   append([]models.DayReadings{}, info.Readings...),
+	}
+	d.Fasting.Period, d.Fasting.NextPeriod, d.Fasting.NextFastFree = statusJSON(info.FastingStatus, info.Date)
+	if pf := info.PersonalFast; pf != nil {
+		d.Fasting.Personal = &personalFastJSON{pf.Level, append([]models.Food{}, pf.Foods.Foods()...), pf.Reason}
+	}
+	for _, f := range info.Feasts {
+		d.Feasts = append(d.Feasts, feastJSON{f.Name, f.GreekName, f.Rank})
+	}
+	for _, s := range info.Saints {
+		d.Saints = append(d.Saints, s.Name)
+	}
+	for _, n := range info.NameDays {
+		d.NameDays = append(d.NameDays, n.Name)
+	}
+	return d
+}
+
+// permittedFoods returns the foods a level permits, never null.
+func permittedFoods(level models.FastingLevel) []models.Food {
+	foods, _ := level.Permits()
+	return append([]models.Food{}, foods.Foods()...)
+}
+
+// newPeriodJSON converts a fasting period, numbering date's day within it.
+func newPeriodJSON(p models.FastingPeriod, date models.Date) periodJSON {
+	j := periodJSON{Name: p.Name, Level: p.Level, Start: p.Start.String(), End: p.End.String(), Days: p.Days()}
+	if p.Contains(date) {
+		j.Day = date.Sub(p.Start) + 1
+	}
+	return j
+}
+
+// statusJSON converts the parts of a fasting status.
+func statusJSON(s models.FastingStatus, date models.Date) (current, next *periodJSON, fastFree string) {
+	if s.Current != nil {
+		p := newPeriodJSON(*s.Current, date)
+		current = &p
+	}
+	if s.Next != nil {
+		p := newPeriodJSON(*s.Next, date)
+		next = &p
+	}
+	if s.NextFastFree != (models.Date{}) {
+		fastFree = s.NextFastFree.String()
+	}
+	return current, next, fastFree
+}
+
+func contactsJSON(contacts []models.Contact) []contactJSON {
+	result := []contactJSON{}
+	for _, c := range contacts {
+		result = append(result, contactJSON{c.Name, c.NameDay})
+	}
+	return result
+}
+
+// PrintJSON writes a day as indented JSON.
+func PrintJSON(info models.DayInfo) {
+	printJSON(newDayJSON(info))
+}
+
+// PrintUpcomingJSON writes the days on which contacts celebrate as indented
+// JSON: a list of dates with the contacts celebrating on each.
+func PrintUpcomingJSON(days []models.DayInfo) {
+	type upcomingJSON struct {
+		Date     string        `json:"date"`
+		Contacts []contactJSON `json:"contacts"`
+	}
+	result := []upcomingJSON{}
+	for _, d := range days {
+		if len(d.Contacts) > 0 {
+			result = append(result, upcomingJSON{d.Date.String(), contactsJSON(d.Contacts)})
+		}
+	}
+	printJSON(result)
+}
+
+// PrintFastsJSON writes a day's fasting status and the fasting periods of
+// its year as indented JSON.
+func PrintFastsJSON(date models.Date, s models.FastingStatus, periods []models.FastingPeriod) {
+	type fastsJSON struct {
+		Date         string       `json:"date"`
+		Period       *periodJSON  `json:"period"`
+		NextPeriod   *periodJSON  `json:"next_period"`
+		NextFastFree string       `json:"next_fast_free,omitempty"`
+		Periods      []periodJSON `json:"periods"`
+	}
+	result := fastsJSON{Date: date.String(), Periods: []periodJSON{}}
+	result.Period, result.NextPeriod, result.NextFastFree = statusJSON(s, date)
+	for _, p := range periods {
+		result.Periods = append(result.Periods, newPeriodJSON(p, date))
+	}
+	printJSON(result)
+}
+
+// countsJSON is the -json form of models.FastingCounts; Levels has every level.
+type countsJSON struct {
+	Days       int                         `json:"days"`
+	FastDays   int                         `json:"fast_days"`
+	StrictDays int                         `json:"strict_days"`
+	FishDays   int                         `json:"fish_days"`
+	WineDays   int                         `json:"wine_days"`
+	Levels     map[models.FastingLevel]int `json:"levels"`
+}
+
+func newCountsJSON(c models.FastingCounts) countsJSON {
+	levels := make(map[models.FastingLevel]int)
+	for _, l := range models.FastingLevels {
+		levels[l] = c.Levels[l]
+	}
+	return countsJSON{c.Days, c.FastDays, c.StrictDays, c.FishDays, c.WineDays, levels}
+}
+
+// PrintFastingStatsJSON writes the fasting statistics as indented JSON.
+func PrintFastingStatsJSON(s models.FastingStats) {
+	type periodStatsJSON struct {
+		periodJSON
+		InRange int `json:"in_range"` // Days of the period within the range
+	}
+	type stretchJSON struct {
+		Start string `json:"start"`
+		End   string `json:"end"`
+		Days  int    `json:"days"`
+	}
+	type monthJSON struct {
+		Month string `json:"month"` // YYYY-MM
+		countsJSON
+	}
+	type statsJSON struct {
+		Start string `json:"start"`
+		End   string `json:"end"`
+		countsJSON
+		Periods   []periodStatsJSON `json:"periods"`
+		Stretches []stretchJSON     `json:"strict_stretches"`
+		Months    []monthJSON       `json:"months"`
+	}
+
+	result := statsJSON{
+		Start:      s.Start.String(),
+		End:        s.End.String(),
+		countsJSON: newCountsJSON(s.FastingCounts),
+		Periods:    []periodStatsJSON{},
+		Stretches:  []stretchJSON{},
+		Months:     []monthJSON{},
+	}
+	for _, ps := range s.Periods {
+		p := ps.Period
+		result.Periods = append(result.Periods, periodStatsJSON{
+			periodJSON{Name: p.Name, Level: p.Level, Start: p.Start.String(), End: p.End.String(), Days: p.Days()}, ps.Days})
+	}
+	for _, st := range s.Stretches {
+		result.Stretches = append(result.Stretches, stretchJSON{st.Start.String(), st.End.String(), st.Days()})
+	}
+	for _, m := range s.Months {
+		result.Months = append(result.Months, monthJSON{fmt.Sprintf("%04d-%02d", m.Year, m.Month), newCountsJSON(m.FastingCounts)})
+	}
+	printJSON(result)
+}
+
+func printJSON(v any) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
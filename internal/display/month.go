@@ -4,22 +4,39 @@ import (
 	"fmt"
 	"greekOrtho/internal/models"
 	"strings"
-	"time"
 )
 
 const (
 	underline = "\033[4m"
+	shade     = "\033[48;5;238m" // Background for days open to weddings
 	cellWidth = 8
 )
 
+// dayMarker returns the grid marker for a day: ✦ for a feast, ✝ for a Saturday
+// of Souls, ✧ for a forefeast, afterfeast or apodosis, or "" for none of these.
+func dayMarker(info models.DayInfo) string {
+	switch {
+	case len(info.Feasts) > 0:
+		return "✦"
+	case len(info.Memorials) > 0:
+		return "✝"
+	case len(info.FeastPeriods) > 0:
+		return "✧"
+	default:
+		return ""
+	}
+}
+
 // PrintMonth renders a monthly calendar grid with fasting colors and feast markers.
-func PrintMonth(days []models.DayInfo, today time.Time) {
+// With showTitles it also lists the liturgical name of each Sunday, and with
+// shadeWeddings it shades the days on which marriages may be celebrated.
+func PrintMonth(days []models.DayInfo, today models.Date, showTitles, shadeWeddings bool) {
 	if len(days) == 0 {
 		return
 	}
 
-	month := days[0].Date.Month()
-	year := days[0].Date.Year()
+	month := days[0].Date.Month
+	year := days[0].Date.Year
 
 	fmt.Println()
 	fmt.Println(topBorder())
@@ -27,6 +44,9 @@ func PrintMonth(days []models.DayInfo, today time.Time) {
 	// Header
 	fmt.Println(emptyLine())
 	title := fmt.Sprintf("☦  Greek Orthodox Calendar — %s %d", month, year)
+	if days[0].Style == models.StyleJulian {
+		title += " (Old Calendar)"
+	}
 	fmt.Println(line(boldGold + "  " + title + reset))
 	fmt.Println(emptyLine())
 
@@ -43,7 +63,7 @@ func PrintMonth(days []models.DayInfo, today time.Time) {
 	// Build lookup maps
 	dayInfo := make(map[int]models.DayInfo)
 	for _, d := range days {
-		dayInfo[d.Date.Day()] = d
+		dayInfo[d.Date.Day] = d
 	}
 
 	// Determine starting weekday offset
@@ -67,17 +87,17 @@ func PrintMonth(days []models.DayInfo, today time.Time) {
 
 			info := dayInfo[dayNum]
 			fastColor, _ := fastingStyle(info.FastingLevel)
+			if shadeWeddings && info.Weddings {
+				fastColor += shade
+			}
 
 			numStr := fmt.Sprintf("%d", dayNum)
-			hasFeast := len(info.Feasts) > 0
-			if hasFeast {
-				numStr += "✦"
-			}
+			marker := dayMarker(info)
+			hasMarker := marker != ""
+			numStr += marker
 
 			// Highlight today
-			isToday := today.Year() == year &&
-				today.Month() == month &&
-				today.Day() == dayNum
+			isToday := today == models.NewDate(year, month, dayNum)
 
 			cell := ""
 			if isToday {
@@ -88,8 +108,8 @@ func PrintMonth(days []models.DayInfo, today time.Time) {
 
 			// Pad to cell width accounting for multi-byte ✦ (3 bytes in UTF-8)
 			visualLen := len(numStr)
-			if hasFeast {
-				visualLen = len(numStr) - 2 // ✦ is 3 bytes but 1 visual char... actually visual width varies
+			if hasMarker {
+				visualLen = len(numStr) - 2 // ✦/✝/✧ is 3 bytes but 1 visual char... actually visual width varies
 			}
 			padding := cellWidth - visualLen
 			if padding < 1 {
@@ -106,15 +126,25 @@ func PrintMonth(days []models.DayInfo, today time.Time) {
 
 	// Legend
 	fmt.Println(divider())
-	legend := "  🔴 Strict  🟠 Oil/Wine  🟡 Fish  🟢 No Fast  ✦ Feast"
+	legend := "  ⚫ Total  🔴 Strict  🟠 Oil/Wine  🟡 Fish  🟢 No Fast"
 	fmt.Println(line(legend))
+	fmt.Println(line("  ✦ Feast  ✧ Forefeast / Afterfeast  ✝ Saturday of Souls"))
+	if shadeWeddings {
+		fmt.Println(line("  " + shade + "  " + reset + " Weddings may be celebrated"))
+	}
 
 	// Feasts this month
 	var feasts []string
 	for _, d := range days {
 		for _, f := range d.Feasts {
 			entry := fmt.Sprintf("  %s %d — %s",
-				d.Date.Format("Jan"), d.Date.Day(), f.Name)
+				d.Date.Format("Jan"), d.Date.Day, f.Name)
+			if d.Style == models.StyleJulian {
+				entry = fmt.Sprintf("  %s — %s", dualDate(d), f.Name)
+			}
+			if f.TransferredFrom != nil {
+				entry += " (transferred from " + transferredFrom(d, f) + ")"
+			}
 			feasts = append(feasts, entry)
 		}
 	}
@@ -127,6 +157,32 @@ func PrintMonth(days []models.DayInfo, today time.Time) {
 		}
 	}
 
+	// Sundays this month by liturgical name
+	if showTitles {
+		fmt.Println(divider())
+		fmt.Println(line(bold + "  Sundays:" + reset))
+		for _, d := range days {
+			if d.Date.Weekday() == 0 && d.LiturgicalDay.Title != "" {
+				fmt.Println(line(white + fmt.Sprintf("  %s %d — %s", d.Date.Format("Jan"), d.Date.Day, d.LiturgicalDay.Title) + reset))
+			}
+		}
+	}
+
+	// Saturdays of Souls this month
+	var memorials []string
+	for _, d := range days {
+		for _, m := range d.Memorials {
+			memorials = append(memorials, fmt.Sprintf("  %s %d — %s", d.Date.Format("Jan"), d.Date.Day, m.Name))
+		}
+	}
+	if len(memorials) > 0 {
+		fmt.Println(divider())
+		fmt.Println(line(bold + "  Saturdays of Souls:" + reset))
+		for _, m := range memorials {
+			fmt.Println(line(white + m + reset))
+		}
+	}
+
 	fmt.Println(bottomBorder())
 	fmt.Println()
 }
@@ -0,0 +1,118 @@
+package display
+
+import (
+	"fmt"
+	"greekOrtho/internal/models"
+	"slices"
+	"strings"
+)
+
+// nameDayLabel returns a name with its Greek form, e.g. "George (Γεώργιος)".
+func nameDayLabel(n models.NameDay) string {
+	if n.GreekName == "" {
+		return n.Name
+	}
+	return n.Name + " (" + n.GreekName + ")"
+}
+
+// nameDayOccasion names the feast or saint that makes a day a name day of n.
+func nameDayOccasion(n models.NameDay, info models.DayInfo) string {
+	for _, f := range info.Feasts {
+		if slices.Contains(n.Commemorations, f.Name) {
+			if f.TransferredFrom != nil {
+				return f.Name + " (transferred from " + transferredFrom(info, f) + ")"
+			}
+			return f.Name
+		}
+	}
+	for _, s := range info.Saints {
+		if slices.Contains(n.Commemorations, s.Name) {
+			return s.Name
+		}
+	}
+	return ""
+}
+
+// PrintNameDay shows the forms of a name and the days in a year it is celebrated.
+func PrintNameDay(n models.NameDay, year int, days []models.DayInfo) {
+	fmt.Println()
+	fmt.Println(topBorder())
+
+	// Header
+	fmt.Println(emptyLine())
+	fmt.Println(line(boldGold + fmt.Sprintf("  ☦  Name Day: %s — %d", nameDayLabel(n), year) + reset))
+	if len(n.Variants) > 0 {
+		for _, l := range wrapWords(strings.Fields(strings.Join(n.Variants, ", ")), contentWidth-4) {
+			fmt.Println(line(dimWhite + "  " + l + reset))
+		}
+	}
+	fmt.Println(emptyLine())
+
+	fmt.Println(divider())
+	fmt.Println(emptyLine())
+	if len(days) == 0 {
+		fmt.Println(line(dimWhite + "  Not celebrated this year" + reset))
+	}
+	for _, d := range days {
+		date := d.Date.Format("Mon Jan 2")
+		if d.Style == models.StyleJulian {
+			date += " (" + shortJulian(d.JulianDate) + " O.S.)"
+		}
+		fmt.Println(line(boldWhite + "  " + date + reset))
+		if occasion := nameDayOccasion(n, d); occasion != "" {
+			for _, l := range wrapWords(strings.Fields(occasion), contentWidth-6) {
+				fmt.Println(line(cyan + "    " + l + reset))
+			}
+		}
+	}
+	fmt.Println(emptyLine())
+
+	fmt.Println(bottomBorder())
+	fmt.Println()
+}
+
+// PrintNameDayYear lists the name days of every day in days that has any.
+func PrintNameDayYear(days []models.DayInfo) {
+	if len(days) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(topBorder())
+
+	// Header
+	fmt.Println(emptyLine())
+	title := fmt.Sprintf("☦  Name Days %d", days[0].Date.Year)
+	if days[0].Style == models.StyleJulian {
+		title += " (Old Calendar)"
+	}
+	fmt.Println(line(boldGold + "  " + title + reset))
+	fmt.Println(emptyLine())
+
+	month := 0
+	for _, d := range days {
+		if len(d.NameDays) == 0 {
+			continue
+		}
+		if int(d.Date.Month) != month {
+			month = int(d.Date.Month)
+			fmt.Println(divider())
+			fmt.Println(line(bold + "  " + d.Date.Format("January") + reset))
+		}
+
+		names := make([]string, len(d.NameDays))
+		for i, n := range d.NameDays {
+			names[i] = n.Name
+		}
+		for i, l := range wrapWords(strings.Fields(strings.Join(names, ", ")), contentWidth-12) {
+			prefix := fmt.Sprintf("  %-8s", d.Date.Format("Mon 2"))
+			if i > 0 {
+				prefix = strings.Repeat(" ", 10)
+			}
+			fmt.Println(line(white + prefix + cyan + l + reset))
+		}
+	}
+
+	fmt.Println(bottomBorder())
+	fmt.Println()
+}
@@ -0,0 +1,72 @@
+package display
+
+import (
+	"fmt"
+	"greekOrtho/internal/pascha"
+)
+
+// PrintPaschalion renders a multi-year table of Orthodox Pascha and Western
+// Easter side by side, with the gap between them and the Paschalion keys.
+func PrintPaschalion(entries []pascha.Paschalion) {
+	if len(entries) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(topBorder())
+
+	// Header
+	fmt.Println(emptyLine())
+	title := fmt.Sprintf("☦  Paschalion %d–%d", entries[0].Year, entries[len(entries)-1].Year)
+	fmt.Println(line(boldGold + "  " + title + reset))
+	fmt.Println(emptyLine())
+
+	fmt.Println(divider())
+	fmt.Println(emptyLine())
+	header := fmt.Sprintf("  %-5s %-7s %-7s %-7s %-7s %3s %3s %3s %3s %s",
+		"Year", "Pascha", "O.S.", "Western", "Gap", "GN", "SC", "Ep", "Ind", "Key")
+	fmt.Println(line(bold + header + reset))
+	fmt.Println(emptyLine())
+
+	for _, e := range entries {
+		row := fmt.Sprintf("  %-5d %-7s %-7s %-7s %-7s %3d %3d %3d %3d %s",
+			e.Year,
+			e.Gregorian.Format("Jan 2"),
+			shortJulian(e.Julian),
+			e.Western.Format("Jan 2"),
+			paschaGap(e),
+			e.GoldenNumber, e.SolarCycle, e.Epact, e.Indiction, e.KeyLetter)
+
+		color := yellow
+		if e.Gregorian == e.Western {
+			color = green
+		}
+		fmt.Println(line(color + row + reset))
+	}
+	fmt.Println(emptyLine())
+
+	// Legend
+	fmt.Println(divider())
+	fmt.Println(line(dimWhite + "  GN Golden Number  SC Solar Cycle  Ep Epact" + reset))
+	fmt.Println(line(dimWhite + "  Ind Indiction  Key Key of Boundaries" + reset))
+	if entries[0].Year < pascha.GregorianReform {
+		fmt.Println(line(dimWhite + "  Before 1583 the civil calendar is O.S.; Pascha dates" + reset))
+		fmt.Println(line(dimWhite + "  in the first column are proleptic Gregorian." + reset))
+	}
+
+	fmt.Println(bottomBorder())
+	fmt.Println()
+}
+
+// paschaGap describes how far Orthodox Pascha falls after Western Easter.
+func paschaGap(e pascha.Paschalion) string {
+	weeks := e.Gregorian.Sub(e.Western) / 7
+	switch weeks {
+	case 0:
+		return "same"
+	case 1:
+		return "1 wk"
+	default:
+		return fmt.Sprintf("%d wks", weeks)
+	}
+}
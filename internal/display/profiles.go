@@ -0,0 +1,116 @@
+package display
+
+import (
+	"fmt"
+	"greekOrtho/internal/data"
+	"strings"
+)
+
+// PrintProfiles lists the jurisdiction profiles with how many entries each changes.
+func PrintProfiles(profiles []data.Profile, changes map[string][]data.Change) {
+	fmt.Println()
+	fmt.Println(topBorder())
+
+	// Header
+	fmt.Println(emptyLine())
+	fmt.Println(line(boldGold + "  ☦  Jurisdiction Profiles" + reset))
+	fmt.Println(emptyLine())
+	fmt.Println(divider())
+
+	for _, p := range profiles {
+		fmt.Println(emptyLine())
+		fmt.Println(line(boldWhite + "  " + p.Name + reset + white + " — " + p.Title + reset))
+		if words := strings.Fields(p.Description); len(words) > 0 {
+			for _, l := range wrapWords(words, contentWidth-6) {
+				fmt.Println(line(dimWhite + "    " + l + reset))
+			}
+		}
+		fmt.Println(line(cyan + "    " + changeSummary(changes[p.Name]) + reset))
+	}
+	fmt.Println(emptyLine())
+
+	fmt.Println(divider())
+	fmt.Println(line(dimWhite + "  orthoCal profiles NAME shows a profile's changes" + reset))
+	fmt.Println(line(dimWhite + "  orthoCal -profile NAME applies it" + reset))
+	fmt.Println(bottomBorder())
+	fmt.Println()
+}
+
+// PrintProfileChanges lists every entry a profile adds, replaces or removes,
+// grouped by data file.
+func PrintProfileChanges(p data.Profile, changes []data.Change) {
+	fmt.Println()
+	fmt.Println(topBorder())
+
+	// Header
+	fmt.Println(emptyLine())
+	fmt.Println(line(boldGold + "  ☦  Profile: " + p.Title + reset))
+	if words := strings.Fields(p.Description); len(words) > 0 {
+		for _, l := range wrapWords(words, contentWidth-4) {
+			fmt.Println(line(dimWhite + "  " + l + reset))
+		}
+	}
+	fmt.Println(emptyLine())
+
+	file := ""
+	for _, c := range changes {
+		if c.File != file {
+			if file != "" {
+				fmt.Println(emptyLine())
+			}
+			file = c.File
+			fmt.Println(divider())
+			fmt.Println(emptyLine())
+			fmt.Println(line(bold + "  " + file + reset))
+		}
+		switch c.Action {
+		case "add":
+			fmt.Println(line(green + "    + " + c.Key + reset))
+		case "replace":
+			fmt.Println(line(yellow + "    ~ " + c.Key + reset))
+		case "remove":
+			fmt.Println(line(red + "    - " + c.Key + reset))
+		}
+	}
+	if len(changes) > 0 {
+		fmt.Println(emptyLine())
+	}
+
+	fmt.Println(divider())
+	fmt.Println(line(dimWhite + "  " + changeSummary(changes) + reset))
+	fmt.Println(bottomBorder())
+	fmt.Println()
+}
+
+// changeSummary counts a profile's changes, e.g. "3 added, 1 replaced".
+func changeSummary(changes []data.Change) string {
+	var added, replaced, removed int
+	for _, c := range changes {
+		switch c.Action {
+		case "add":
+			added++
+		case "replace":
+			replaced++
+		case "remove":
+			removed++
+		}
+	}
+
+	summary := ""
+	for _, part := range []struct {
+		n    int
+		verb string
+	}{{added, "added"}, {replaced, "replaced"}, {removed, "removed"}} {
+		if part.n == 0 {
+			continue
+		}
+		if summary != "" {
+			summary += ", "
+		}
+		summary += fmt.Sprintf("%d %s", part.n, part.verb)
+	}
+	if summary == "" {
+		return "No changes"
+	}
+	return summary
+}
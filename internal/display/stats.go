@@ -0,0 +1,192 @@
+package display
+
+import (
+	"encoding/csv"
+	"fmt"
+	"greekOrtho/internal/models"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// maxStretches is how many of the longest strict stretches the table shows.
+const maxStretches = 5
+
+// PrintFastingStats shows how the fasting of a range of days breaks down: the
+// days per level and per named period, the longest strict stretches and a
+// table of months.
+func PrintFastingStats(s models.FastingStats, style models.CalendarStyle) {
+	if s.Days == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(topBorder())
+
+	// Header
+	fmt.Println(emptyLine())
+	title := fmt.Sprintf("Fasting — %s to %s", s.Start.Format("Jan 2, 2006"), s.End.Format("Jan 2, 2006"))
+	if s.Start.Month == 1 && s.Start.Day == 1 && s.End == models.NewDate(s.Start.Year, 12, 31) {
+		title = fmt.Sprintf("Fasting Year %d", s.Start.Year)
+	}
+	fmt.Println(line(boldGold + "  ☦  " + title + reset))
+	if style == models.StyleJulian {
+		fmt.Println(line(dimWhite + "  Old Calendar" + reset))
+	}
+	fmt.Println(emptyLine())
+
+	// Summary
+	fmt.Println(divider())
+	fmt.Println(emptyLine())
+	fmt.Println(line(white + fmt.Sprintf("  %-22s %4d of %d (%d%%)", "Fast days", s.FastDays, s.Days, percent(s.FastDays, s.Days)) + reset))
+	fmt.Println(line(white + fmt.Sprintf("  %-22s %4d", "Strict or stricter", s.StrictDays) + reset))
+	fmt.Println(line(white + fmt.Sprintf("  %-22s %4d", "Fish permitted", s.FishDays) + reset))
+	fmt.Println(line(white + fmt.Sprintf("  %-22s %4d", "Wine permitted", s.WineDays) + reset))
+	fmt.Println(emptyLine())
+
+	// Levels
+	fmt.Println(divider())
+	fmt.Println(emptyLine())
+	fmt.Println(line(boldCyan + "  Days by Level" + reset))
+	most := 0
+	for _, n := range s.Levels {
+		most = max(most, n)
+	}
+	for _, l := range models.FastingLevels {
+		n := s.Levels[l]
+		if n == 0 {
+			continue
+		}
+		color, icon := fastingStyle(l)
+		bar := strings.Repeat("█", max(1, n*20/most))
+		fmt.Println(line(fmt.Sprintf("  %s %s%-13s%s%4d %3d%%  %s%s", icon, white, shortFastingLabel(l), reset, n, percent(n, s.Days), color+bar, reset)))
+	}
+	fmt.Println(emptyLine())
+
+	// Periods
+	if len(s.Periods) > 0 {
+		fmt.Println(divider())
+		fmt.Println(emptyLine())
+		fmt.Println(line(boldCyan + "  Fasting Periods" + reset))
+		for _, ps := range s.Periods {
+			p := ps.Period
+			_, icon := fastingStyle(p.Level)
+			days := fmt.Sprintf("%d days", ps.Days)
+			if ps.Days < p.Days() {
+				days = fmt.Sprintf("%d of %d days", ps.Days, p.Days())
+			}
+			span := p.Start.Format("Jan 2") + " – " + p.End.Format("Jan 2")
+			fmt.Println(line(fmt.Sprintf("  %s %s%-16s%s%-17s%s%s", icon, white, p.Name, dimWhite, span, days, reset)))
+		}
+		fmt.Println(emptyLine())
+	}
+
+	// Strict stretches
+	fmt.Println(divider())
+	fmt.Println(emptyLine())
+	fmt.Println(line(boldCyan + "  Longest Strict Stretches" + reset))
+	if len(s.Stretches) == 0 {
+		fmt.Println(line(dimWhite + "    None" + reset))
+	}
+	for _, st := range s.Stretches[:min(len(s.Stretches), maxStretches)] {
+		span := st.Start.Format("Mon Jan 2")
+		if st.End != st.Start {
+			span += " – " + st.End.Format("Mon Jan 2")
+		}
+		fmt.Println(line(white + fmt.Sprintf("    %-30s %2d %s", span, st.Days(), plural(st.Days(), "day", "days")) + reset))
+	}
+	fmt.Println(emptyLine())
+
+	// Months
+	fmt.Println(divider())
+	fmt.Println(emptyLine())
+	fmt.Println(line(boldCyan + "  By Month" + reset))
+	fmt.Println(line(dimWhite + "            Days  Fast  Strict  Fish  Wine  Free" + reset))
+	for _, m := range s.Months {
+		label := m.Month.String()[:3]
+		if s.Start.Year != s.End.Year {
+			label += fmt.Sprintf(" %d", m.Year)
+		}
+		fmt.Println(line(white + monthRow(label, m.FastingCounts) + reset))
+	}
+	fmt.Println(line(bold + monthRow("Total", s.FastingCounts) + reset))
+	fmt.Println(emptyLine())
+
+	fmt.Println(bottomBorder())
+	fmt.Println()
+}
+
+// monthRow formats one row of the month table.
+func monthRow(label string, c models.FastingCounts) string {
+	return fmt.Sprintf("  %-9s %5d %5d %7d %5d %5d %5d", label, c.Days, c.FastDays, c.StrictDays, c.FishDays, c.WineDays, c.Levels[models.FastingNone])
+}
+
+// percent returns n as a whole percentage of total.
+func percent(n, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return (n*100 + total/2) / total
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
+
+// PrintFastingStatsCSV writes the statistics as CSV, one count per row:
+// section (total, period, stretch or month), name, level, first and last day,
+// and the number of days. The total and month rows give each count by name
+// (days, fast_days, strict_days, fish_days, wine_days) and the days at each
+// level by level; period rows give the whole period and its days in range.
+func PrintFastingStatsCSV(s models.FastingStats) {
+	w := csv.NewWriter(os.Stdout)
+	row := func(section, name string, level models.FastingLevel, start, end models.Date, days int) {
+		w.Write([]string{section, name, string(level), start.String(), end.String(), strconv.Itoa(days)})
+	}
+	counts := func(section string, start, end models.Date, c models.FastingCounts) {
+		row(section, "days", "", start, end, c.Days)
+		row(section, "fast_days", "", start, end, c.FastDays)
+		row(section, "strict_days", "", start, end, c.StrictDays)
+		row(section, "fish_days", "", start, end, c.FishDays)
+		row(section, "wine_days", "", start, end, c.WineDays)
+		for _, l := range models.FastingLevels {
+			row(section, "", l, start, end, c.Levels[l])
+		}
+	}
+
+	w.Write([]string{"section", "name", "level", "start", "end", "days"})
+	counts("total", s.Start, s.End, s.FastingCounts)
+	for _, ps := range s.Periods {
+		row("period", ps.Period.Name, ps.Period.Level, ps.Period.Start, ps.Period.End, ps.Days)
+	}
+	for _, st := range s.Stretches {
+		row("stretch", "", "", st.Start, st.End, st.Days())
+	}
+	for _, m := range s.Months {
+		first := models.NewDate(m.Year, m.Month, 1)
+		last := models.NewDate(m.Year, m.Month+1, 0)
+		counts("month", maxDate(first, s.Start), minDate(last, s.End), m.FastingCounts)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func minDate(a, b models.Date) models.Date {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+func maxDate(a, b models.Date) models.Date {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
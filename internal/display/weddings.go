@@ -0,0 +1,65 @@
+package display
+
+import (
+	"fmt"
+	"greekOrtho/internal/models"
+)
+
+// PrintWeddings lists the days on which marriages may be celebrated, with each
+// day's feast or liturgical name, and how many of the given days are open.
+func PrintWeddings(days []models.DayInfo) {
+	if len(days) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(topBorder())
+
+	// Header
+	fmt.Println(emptyLine())
+	first, last := days[0].Date, days[len(days)-1].Date
+	title := fmt.Sprintf("☦  Wedding Dates — %s to %s", first.Format("Jan 2, 2006"), last.Format("Jan 2, 2006"))
+	fmt.Println(line(boldGold + "  " + title + reset))
+	if days[0].Style == models.StyleJulian {
+		fmt.Println(line(dimWhite + "  Old Calendar" + reset))
+	}
+	fmt.Println(emptyLine())
+	fmt.Println(divider())
+	fmt.Println(emptyLine())
+
+	count := 0
+	for _, d := range days {
+		if !d.Weddings {
+			continue
+		}
+		count++
+
+		date := d.Date.Format("Mon Jan 2")
+		if d.Style == models.StyleJulian {
+			date += " (" + shortJulian(d.JulianDate) + " O.S.)"
+		}
+		entry := "  " + green + date + reset
+		if name := weddingDayName(d); name != "" {
+			entry += dimWhite + " — " + name + reset
+		}
+		fmt.Println(line(entry))
+	}
+	if count == 0 {
+		fmt.Println(line(dimWhite + "  No weddings are celebrated in this period" + reset))
+	}
+	fmt.Println(emptyLine())
+
+	fmt.Println(divider())
+	fmt.Println(line(dimWhite + fmt.Sprintf("  %d of %d days open to weddings", count, len(days)) + reset))
+	fmt.Println(bottomBorder())
+	fmt.Println()
+}
+
+// weddingDayName names a day in the wedding list by its first feast or, failing
+// that, its liturgical name.
+func weddingDayName(d models.DayInfo) string {
+	if len(d.Feasts) > 0 {
+		return d.Feasts[0].Name
+	}
+	return d.LiturgicalDay.Title
+}
@@ -0,0 +1,161 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Date is a civil calendar date with no time of day or time zone. Day
+// arithmetic on Date is exact, so results never drift across DST changes.
+//
+// Date normally holds a Gregorian date. Julian returns the same day on the
+// Julian calendar in the same type, which is how Old Calendar dates such as
+// Feb 29, 2100 O.S. are represented.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns the Gregorian date for year, month and day, normalizing
+// out-of-range values the way time.Date does (e.g. Jan 32 becomes Feb 1).
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the calendar date of t in t's own location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// Today returns the current local date.
+func Today() Date {
+	return DateOf(time.Now())
+}
+
+// ParseDate parses a date in YYYY-MM-DD format.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// FromJulian returns the Gregorian date of the given Julian-calendar date.
+func FromJulian(year int, month time.Month, day int) Date {
+	return dateFromJDN(julianJDN(year, int(month), day))
+}
+
+// Time returns the date as a time.Time at midnight UTC, for formatting.
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// Format formats the date with a time package layout. Julian dates that do not
+// exist on the Gregorian calendar (Feb 29, 2100 O.S.) are normalized by it.
+func (d Date) Format(layout string) string {
+	return d.Time().Format(layout)
+}
+
+// String returns the date in YYYY-MM-DD format.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// MarshalText encodes the date in YYYY-MM-DD format.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText decodes a date in YYYY-MM-DD format.
+func (d *Date) UnmarshalText(b []byte) error {
+	parsed, err := ParseDate(string(b))
+	if err != nil {
+		return fmt.Errorf("invalid date %q (use YYYY-MM-DD)", b)
+	}
+	*d = parsed
+	return nil
+}
+
+// AddDays returns the date n days after d (before d if n is negative).
+func (d Date) AddDays(n int) Date {
+	return dateFromJDN(d.jdn() + n)
+}
+
+// Sub returns the number of days from o to d.
+func (d Date) Sub(o Date) int {
+	return d.jdn() - o.jdn()
+}
+
+// Before reports whether d is before o.
+func (d Date) Before(o Date) bool {
+	return d.jdn() < o.jdn()
+}
+
+// After reports whether d is after o.
+func (d Date) After(o Date) bool {
+	return d.jdn() > o.jdn()
+}
+
+// Weekday returns the day of the week of d.
+func (d Date) Weekday() time.Weekday {
+	return time.Weekday((d.jdn() + 1) % 7)
+}
+
+// YearDay returns the day of the year of d, in the range [1, 366].
+func (d Date) YearDay() int {
+	return d.jdn() - gregorianJDN(d.Year, 1, 1) + 1
+}
+
+// Julian returns the same day on the Julian calendar.
+func (d Date) Julian() Date {
+	c := d.jdn() + 32082
+	y := (4*c + 3) / 1461
+	e := c - 1461*y/4
+	m := (5*e + 2) / 153
+
+	return Date{
+		Year:  y - 4800 + m/10,
+		Month: time.Month(m + 3 - 12*(m/10)),
+		Day:   e - (153*m+2)/5 + 1,
+	}
+}
+
+// jdn returns the Julian Day Number of the Gregorian date d.
+func (d Date) jdn() int {
+	return gregorianJDN(d.Year, int(d.Month), d.Day)
+}
+
+// gregorianJDN returns the Julian Day Number of a Gregorian date.
+func gregorianJDN(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// julianJDN returns the Julian Day Number of a Julian-calendar date.
+func julianJDN(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - 32083
+}
+
+// dateFromJDN returns the Gregorian date of a Julian Day Number.
+func dateFromJDN(jdn int) Date {
+	a := jdn + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+
+	return Date{
+		Year:  100*b + d - 4800 + m/10,
+		Month: time.Month(m + 3 - 12*(m/10)),
+		Day:   e - (153*m+2)/5 + 1,
+	}
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDate_Julian(t *testing.T) {
+	tests := []struct {
+		civil  Date
+		julian Date
+	}{
+		{NewDate(2027, 1, 7), Date{2026, 12, 25}},
+		{NewDate(2026, 4, 12), Date{2026, 3, 30}},
+		{NewDate(1582, 10, 15), Date{1582, 10, 5}},
+		{NewDate(2100, 3, 14), Date{2100, 2, 29}},
+		{NewDate(2100, 3, 15), Date{2100, 3, 1}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.civil.Julian(); got != tt.julian {
+			t.Errorf("%s.Julian() = %s, want %s", tt.civil, got, tt.julian)
+		}
+		if got := FromJulian(tt.julian.Year, tt.julian.Month, tt.julian.Day); got != tt.civil {
+			t.Errorf("FromJulian(%s) = %s, want %s", tt.julian, got, tt.civil)
+		}
+	}
+}
+
+func TestDate_MatchesTime(t *testing.T) {
+	start := time.Date(1500, 1, 1, 0, 0, 0, 0, time.UTC)
+	base := DateOf(start)
+	for i := 0; i < 365*700; i += 37 {
+		want := start.AddDate(0, 0, i)
+		got := base.AddDays(i)
+		if got != DateOf(want) {
+			t.Fatalf("AddDays(%d) = %s, want %s", i, got, want.Format("2006-01-02"))
+		}
+		if got.Weekday() != want.Weekday() {
+			t.Fatalf("%s: Weekday = %s, want %s", got, got.Weekday(), want.Weekday())
+		}
+		if got.YearDay() != want.YearDay() {
+			t.Fatalf("%s: YearDay = %d, want %d", got, got.YearDay(), want.YearDay())
+		}
+		if got.Sub(base) != i {
+			t.Fatalf("%s: Sub = %d, want %d", got, got.Sub(base), i)
+		}
+		j := got.Julian()
+		if back := FromJulian(j.Year, j.Month, j.Day); back != got {
+			t.Fatalf("Julian round trip %s -> %s -> %s", got, j, back)
+		}
+	}
+}
+
+func TestDateOf_IgnoresZoneAndClock(t *testing.T) {
+	// 23:30 on the eve of a DST change in New York is still the same civil day
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone data unavailable")
+	}
+	pascha := DateOf(time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC))
+	date := DateOf(time.Date(2026, 3, 8, 23, 30, 0, 0, ny))
+
+	if got := pascha.Sub(date); got != 35 {
+		t.Errorf("days from %s to Pascha = %d, want 35", date, got)
+	}
+}
+
+func TestDate_Text(t *testing.T) {
+	d := NewDate(2026, 3, 1)
+	b, err := d.MarshalText()
+	if err != nil || string(b) != "2026-03-01" {
+		t.Fatalf("MarshalText = %q, %v", b, err)
+	}
+	var got Date
+	if err := got.UnmarshalText(b); err != nil || got != d {
+		t.Errorf("UnmarshalText(%q) = %s, %v", b, got, err)
+	}
+	if err := got.UnmarshalText([]byte("March 1")); err == nil {
+		t.Error("expected an error for a date not in YYYY-MM-DD format")
+	}
+}
@@ -1,56 +1,449 @@
 package models
 
-import "time"
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// CalendarStyle selects which calendar fixed-date commemorations follow.
+type CalendarStyle string
+
+const (
+	StyleRevised CalendarStyle = "revised" // New Calendar: fixed feasts on the civil (Gregorian) date
+	StyleJulian  CalendarStyle = "julian"  // Old Calendar: fixed feasts on the Julian date
+)
 
-// FastingLevel represents the severity of a fast day.
+// FastingLevel names a fast by the foods it permits; see Permits.
 type FastingLevel string
 
 const (
-	FastingStrict    FastingLevel = "strict"     // No meat, dairy, fish, oil, or wine
+	FastingTotal     FastingLevel = "total"      // Total abstinence, by custom (Clean Monday, Holy Friday)
+	FastingXerophagy FastingLevel = "xerophagy"  // Dry eating: bread, raw vegetables, fruit
+	FastingStrict    FastingLevel = "strict"     // No meat, dairy, eggs, fish, oil, or wine; shellfish permitted
+	FastingWine      FastingLevel = "wine"       // Wine permitted, without oil (Holy Saturday)
 	FastingOilWine   FastingLevel = "oil_wine"   // Oil and wine permitted
 	FastingFish      FastingLevel = "fish"       // Fish, oil, and wine permitted
-	FastingDairyFish FastingLevel = "dairy_fish" // Dairy and fish permitted (Cheesefare)
+	FastingDairyFish FastingLevel = "dairy_fish" // Dairy, eggs and fish permitted (Cheesefare)
 	FastingNone      FastingLevel = "none"       // No fasting
 )
 
-// FastingLevelSeverity returns a numeric severity (lower = more strict).
-func FastingLevelSeverity(level FastingLevel) int {
-	switch level {
-	case FastingStrict:
-		return 0
-	case FastingOilWine:
-		return 1
-	case FastingFish:
-		return 2
-	case FastingDairyFish:
-		return 3
-	case FastingNone:
-		return 4
+// FastingLevels lists the named fasting levels from the strictest to the most lenient.
+var FastingLevels = []FastingLevel{
+	FastingTotal, FastingXerophagy, FastingStrict, FastingWine,
+	FastingOilWine, FastingFish, FastingDairyFish, FastingNone,
+}
+
+// Food is a category of food that a fast permits or forbids. Besides the
+// foods fasts are usually described by, dry and cooked food separate total
+// abstinence, xerophagy and the ordinary strict fast.
+type Food string
+
+const (
+	FoodDry       Food = "dry"       // Bread, raw vegetables, fruit, nuts
+	FoodCooked    Food = "cooked"    // Cooked vegetables and legumes, without oil
+	FoodShellfish Food = "shellfish" // Shellfish and other invertebrates (octopus, squid)
+	FoodWine      Food = "wine"
+	FoodOil       Food = "oil"
+	FoodFish      Food = "fish"
+	FoodEggs      Food = "eggs"
+	FoodDairy     Food = "dairy"
+	FoodMeat      Food = "meat"
+)
+
+// Foods lists the food categories in the order fasts relax them.
+var Foods = []Food{
+	FoodDry, FoodCooked, FoodShellfish, FoodWine, FoodOil,
+	FoodFish, FoodEggs, FoodDairy, FoodMeat,
+}
+
+// Name returns a readable name of the food category, e.g. "dry food".
+func (f Food) Name() string {
+	switch f {
+	case FoodDry:
+		return "dry food"
+	case FoodCooked:
+		return "cooked food"
 	default:
-		return -1
+		return string(f)
+	}
+}
+
+// FoodSet is a set of food categories.
+type FoodSet uint16
+
+// NewFoodSet returns the set of the given foods. Unknown foods are ignored.
+func NewFoodSet(foods ...Food) FoodSet {
+	var s FoodSet
+	for _, f := range foods {
+		s |= foodBit(f)
+	}
+	return s
+}
+
+// foodBit returns the set holding only f, or the empty set if f is unknown.
+func foodBit(f Food) FoodSet {
+	for i, g := range Foods {
+		if g == f {
+			return 1 << i
+		}
 	}
+	return 0
+}
+
+// Has reports whether s contains f.
+func (s FoodSet) Has(f Food) bool {
+	b := foodBit(f)
+	return b != 0 && s&b != 0
+}
+
+// Foods returns the foods in s, in the order of Foods.
+func (s FoodSet) Foods() []Food {
+	var result []Food
+	for _, f := range Foods {
+		if s.Has(f) {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// SubsetOf reports whether every food in s is also in o.
+func (s FoodSet) SubsetOf(o FoodSet) bool {
+	return s&^o == 0
+}
+
+// fastingFoods maps each named level to the foods it permits.
+var fastingFoods = map[FastingLevel]FoodSet{
+	FastingTotal:     0,
+	FastingXerophagy: NewFoodSet(FoodDry),
+	FastingStrict:    NewFoodSet(FoodDry, FoodCooked, FoodShellfish),
+	FastingWine:      NewFoodSet(FoodDry, FoodCooked, FoodShellfish, FoodWine),
+	FastingOilWine:   NewFoodSet(FoodDry, FoodCooked, FoodShellfish, FoodWine, FoodOil),
+	FastingFish:      NewFoodSet(FoodDry, FoodCooked, FoodShellfish, FoodWine, FoodOil, FoodFish),
+	FastingDairyFish: NewFoodSet(FoodDry, FoodCooked, FoodShellfish, FoodWine, FoodOil, FoodFish, FoodEggs, FoodDairy),
+	FastingNone:      NewFoodSet(Foods...),
+}
+
+// Permits returns the foods a fasting level permits, and false if the level is unknown.
+func (l FastingLevel) Permits() (FoodSet, bool) {
+	s, ok := fastingFoods[l]
+	return s, ok
+}
+
+// Known reports whether l is a named fasting level.
+func (l FastingLevel) Known() bool {
+	_, ok := fastingFoods[l]
+	return ok
+}
+
+// StricterThan reports whether l permits fewer foods than o: everything l
+// permits, o permits too, and o permits more. Levels are only partially
+// ordered, so neither of two levels may be stricter than the other; unknown
+// levels are never stricter nor more lenient.
+func (l FastingLevel) StricterThan(o FastingLevel) bool {
+	a, okA := l.Permits()
+	b, okB := o.Permits()
+	return okA && okB && a != b && a.SubsetOf(b)
+}
+
+// LevelFor returns the named fasting level that permits exactly foods, if any.
+func LevelFor(foods FoodSet) (FastingLevel, bool) {
+	for _, l := range FastingLevels {
+		if fastingFoods[l] == foods {
+			return l, true
+		}
+	}
+	return "", false
+}
+
+// FastingPractice is how strictly a person keeps the fasts.
+type FastingPractice string
+
+const (
+	PracticeMonastic FastingPractice = "monastic" // The canonical fast, and never meat
+	PracticeLay      FastingPractice = "lay"      // The canonical fast
+	PracticeLenient  FastingPractice = "lenient"  // Oil and wine on every fast day
+	PracticeCustom   FastingPractice = "custom"   // The canonical fast with foods always permitted or forbidden
+)
+
+// FastingProfile is a person's fasting practice and dispensations, read from
+// their configuration. It adjusts the canonical fast of each day.
+type FastingProfile struct {
+	Practice      FastingPractice `json:"profile"`
+	Permit        []Food          `json:"permit,omitempty"` // Custom: foods always permitted
+	Forbid        []Food          `json:"forbid,omitempty"` // Custom: foods never permitted
+	Dispensations []Dispensation  `json:"dispensations,omitempty"`
+}
+
+// Dispensation relaxes the fast for a time, e.g. for illness or nursing.
+type Dispensation struct {
+	From   Date         `json:"from"`
+	To     Date         `json:"to"`               // Last day, inclusive
+	Level  FastingLevel `json:"level,omitempty"`  // Foods of this level are permitted
+	Permit []Food       `json:"permit,omitempty"` // Further foods permitted
+	Reason string       `json:"reason"`
+}
+
+// PersonalFast is a day's fast after a FastingProfile is applied.
+type PersonalFast struct {
+	Foods  FoodSet
+	Level  FastingLevel // The named level permitting exactly Foods, or "" if none does
+	Reason string       // Why it differs from the canonical fast; "" if it does not
+}
+
+// FoodItem is an ingredient or dish in the food taxonomy, with the food
+// categories it counts as on fast days.
+type FoodItem struct {
+	Name       string   `json:"name"`              // English, e.g. "cheese"
+	GreekName  string   `json:"greek_name"`        // e.g. "τυρί"
+	Aliases    []string `json:"aliases,omitempty"` // Other names and dishes, in either language
+	Categories []Food   `json:"categories"`        // Every category it contains; all must be permitted
+	Note       string   `json:"note,omitempty"`    // Custom behind an unusual classification
 }
 
+// Foods returns the set of the item's categories.
+func (f FoodItem) Foods() FoodSet {
+	return NewFoodSet(f.Categories...)
+}
+
+// FoodVerdict answers whether a food item may be eaten on a day.
+type FoodVerdict struct {
+	Item      FoodItem
+	Date      Date
+	Permitted bool
+	Forbidden []Food        // The item's categories the day's fast forbids
+	Level     FastingLevel  // The day's canonical fasting level
+	Rule      string        // The fasting rule or feast that decides the level; "" on a fast-free day
+	Reason    string        // The canonical fasting reason
+	Personal  *PersonalFast // The personal fast the verdict follows, if a profile is set
+}
+
+// Liturgy is the eucharistic service celebrated on a day.
+type Liturgy string
+
+const (
+	LiturgyChrysostom    Liturgy = "chrysostom"    // St. John Chrysostom, the ordinary Liturgy
+	LiturgyBasil         Liturgy = "basil"         // St. Basil the Great
+	LiturgyPresanctified Liturgy = "presanctified" // Presanctified Gifts, on Lenten weekdays
+	LiturgyNone          Liturgy = "none"          // Aliturgical day
+)
+
+// Kneeling is whether the faithful kneel or make prostrations on a day.
+type Kneeling string
+
+const (
+	KneelingPermitted    Kneeling = "kneeling"     // Ordinary days: kneeling at the appointed places
+	KneelingNone         Kneeling = "none"         // No kneeling: Sundays, the Paschal season, the Twelve Days
+	KneelingProstrations Kneeling = "prostrations" // Lenten weekdays: prostrations at the Prayer of St. Ephraim
+)
+
 // WeekdayOverride allows different fasting levels on specific weekdays within a period.
 type WeekdayOverride struct {
 	Weekday time.Weekday `json:"weekday"`
 	Level   FastingLevel `json:"level"`
 }
 
+// DateSpan selects the days a rule covers: a Pascha-offset range, a fixed
+// month/day range, a Pascha-offset start with a fixed end (the Apostles' Fast),
+// or every occurrence of a weekday.
+type DateSpan struct {
+	PaschaOffsetStart *int `json:"pascha_offset_start,omitempty"` // Days from Pascha
+	PaschaOffsetEnd   *int `json:"pascha_offset_end,omitempty"`
+	FixedStartMonth   *int `json:"fixed_start_month,omitempty"`
+	FixedStartDay     *int `json:"fixed_start_day,omitempty"`
+	FixedEndMonth     *int `json:"fixed_end_month,omitempty"`
+	FixedEndDay       *int `json:"fixed_end_day,omitempty"`
+	WeekdayOnly       *int `json:"weekday_only,omitempty"` // 0=Sunday .. 6=Saturday
+}
+
+// FeastRelaxation relaxes a fasting rule on days with a feast of one of the
+// given ranks or kinds, e.g. fish for a major feast on a Wednesday.
+type FeastRelaxation struct {
+	Ranks       []FeastRank    `json:"ranks,omitempty"`    // Feast ranks that qualify; any if empty
+	Kinds       []FeastKind    `json:"kinds,omitempty"`    // Feast kinds that qualify; any if empty
+	Weekdays    []time.Weekday `json:"weekdays,omitempty"` // Days it applies on; every day if empty
+	Level       FastingLevel   `json:"level"`
+	Description string         `json:"description"` // e.g. "fish for the feast on a fast day"
+}
+
+// Matches reports whether a feast on a given weekday qualifies for r.
+func (r FeastRelaxation) Matches(f Feast, weekday time.Weekday) bool {
+	if len(r.Weekdays) > 0 && !slices.Contains(r.Weekdays, weekday) {
+		return false
+	}
+	if len(r.Ranks) > 0 && !slices.Contains(r.Ranks, f.Rank) {
+		return false
+	}
+	if len(r.Kinds) > 0 && !slices.Contains(r.Kinds, f.Kind) {
+		return false
+	}
+	return true
+}
+
 // FastingRule defines a fasting period with priority-based resolution.
 type FastingRule struct {
-	Name              string            `json:"name"`
-	Level             FastingLevel      `json:"level"`
-	Priority          int               `json:"priority"`
-	PaschaOffsetStart *int              `json:"pascha_offset_start,omitempty"` // Days from Pascha
-	PaschaOffsetEnd   *int              `json:"pascha_offset_end,omitempty"`
-	FixedStartMonth   *int              `json:"fixed_start_month,omitempty"`
-	FixedStartDay     *int              `json:"fixed_start_day,omitempty"`
-	FixedEndMonth     *int              `json:"fixed_end_month,omitempty"`
-	FixedEndDay       *int              `json:"fixed_end_day,omitempty"`
-	WeekdayOnly       *int              `json:"weekday_only,omitempty"` // 0=Sunday .. 6=Saturday
-	WeekdayOverrides  []WeekdayOverride `json:"weekday_overrides,omitempty"`
-	Description       string            `json:"description"`
+	Name     string       `json:"name"`
+	Level    FastingLevel `json:"level"`
+	Priority int          `json:"priority"`
+	DateSpan
+	WeekdayOverrides []WeekdayOverride `json:"weekday_overrides,omitempty"`
+	FeastRelaxations []FeastRelaxation `json:"feast_relaxations,omitempty"` // Applied after the weekday override, only if more lenient
+	Description      string            `json:"description"`
+}
+
+// FastingExplanation traces how a day's fasting level was resolved: the rules
+// whose span covers the day, the weekday override of the chosen rule and the
+// feast overrides considered after it.
+type FastingExplanation struct {
+	Level           FastingLevel
+	Reason          string
+	Candidates      []FastingCandidate      // Matching rules, in data order
+	WeekdayOverride *WeekdayOverride        // Override of the chosen rule applied on the day, if any
+	Relaxations     []FeastOverrideDecision // Feast relaxations of the chosen rule that matched a feast
+	FeastOverrides  []FeastOverrideDecision
+}
+
+// FastingCandidate is a fasting rule that covers a day.
+type FastingCandidate struct {
+	Rule   FastingRule
+	Chosen bool // Whether it had the highest priority (the first such rule wins ties)
+}
+
+// FeastOverrideDecision records whether a feast's fasting override, or a
+// rule's relaxation for a feast, was applied.
+type FeastOverrideDecision struct {
+	Feast    string
+	Level    FastingLevel
+	Accepted bool
+	Note     string // Why it was accepted or rejected
+}
+
+// FastingPeriod is a named fast, from a fasting rule's date span, as it falls
+// in one year.
+type FastingPeriod struct {
+	Name        string
+	Level       FastingLevel // The rule's level; weekdays and feasts within may differ
+	Description string
+	Start       Date
+	End         Date // Last day, inclusive
+}
+
+// Days returns the number of days in the period.
+func (p FastingPeriod) Days() int {
+	return p.End.Sub(p.Start) + 1
+}
+
+// Contains reports whether date falls within the period.
+func (p FastingPeriod) Contains(date Date) bool {
+	return !date.Before(p.Start) && !date.After(p.End)
+}
+
+// FastingDay is a day's canonical fasting level.
+type FastingDay struct {
+	Date   Date
+	Level  FastingLevel
+	Reason string
+}
+
+// FastingCounts counts days by their fasting.
+type FastingCounts struct {
+	Days       int
+	FastDays   int                  // Days with any fasting
+	StrictDays int                  // Days of the strict fast or stricter
+	FishDays   int                  // Fast days on which fish is permitted
+	WineDays   int                  // Fast days on which wine is permitted
+	Levels     map[FastingLevel]int // Days at each level
+}
+
+// Add counts a day at the given level.
+func (c *FastingCounts) Add(level FastingLevel) {
+	if c.Levels == nil {
+		c.Levels = make(map[FastingLevel]int)
+	}
+	c.Days++
+	c.Levels[level]++
+	if level == FastingNone {
+		return
+	}
+	c.FastDays++
+	if level == FastingStrict || level.StricterThan(FastingStrict) {
+		c.StrictDays++
+	}
+	foods, _ := level.Permits()
+	if foods.Has(FoodFish) {
+		c.FishDays++
+	}
+	if foods.Has(FoodWine) {
+		c.WineDays++
+	}
+}
+
+// FastingStats breaks down the fasting of a range of days.
+type FastingStats struct {
+	Start, End Date
+	FastingCounts
+	Periods   []PeriodStats    // Named fasting periods overlapping the range, in date order
+	Stretches []FastingStretch // Runs of strict days, longest first
+	Months    []MonthStats
+}
+
+// PeriodStats counts the days of a named fasting period within a range.
+type PeriodStats struct {
+	Period FastingPeriod
+	Days   int // Days of the period within the range
+}
+
+// FastingStretch is a run of consecutive days of the strict fast or stricter.
+type FastingStretch struct {
+	Start, End Date
+}
+
+// Days returns the number of days in the stretch.
+func (s FastingStretch) Days() int {
+	return s.End.Sub(s.Start) + 1
+}
+
+// MonthStats counts the days of a month within a range.
+type MonthStats struct {
+	Year  int
+	Month time.Month
+	FastingCounts
+}
+
+// FastingStatus places a day among the fasting periods.
+type FastingStatus struct {
+	Current      *FastingPeriod // The period the day is in, if any
+	Next         *FastingPeriod // The first period starting after the day and after Current
+	NextFastFree Date           // The first day after this one without fasting; zero if none within a year
+}
+
+// WeddingRule defines a period in which marriages are not celebrated. Its span
+// is matched like a FastingRule's; a rule with GreatFeastEve set instead covers
+// the eve of every great feast.
+type WeddingRule struct {
+	Name string `json:"name"`
+	DateSpan
+	GreatFeastEve bool   `json:"great_feast_eve,omitempty"`
+	Description   string `json:"description"`
+}
+
+// KneelingOverride sets a different kneeling rule on a weekday within a period.
+type KneelingOverride struct {
+	Weekday     time.Weekday `json:"weekday"`
+	Level       Kneeling     `json:"level"`
+	Description string       `json:"description,omitempty"` // Replaces the rule's description on that weekday
+}
+
+// KneelingRule defines a period with its own kneeling rule. Like fasting rules,
+// the highest-priority matching rule wins.
+type KneelingRule struct {
+	Name     string   `json:"name"`
+	Level    Kneeling `json:"level"`
+	Priority int      `json:"priority"`
+	DateSpan
+	WeekdayOverrides []KneelingOverride `json:"weekday_overrides,omitempty"`
+	Description      string             `json:"description"`
 }
 
 // FeastRank indicates the importance of a feast day.
@@ -62,16 +455,166 @@ const (
 	RankMinor FeastRank = "minor"
 )
 
+// FeastKind is whom a feast celebrates, for rules that single out feasts of
+// the Lord or of the Theotokos.
+type FeastKind string
+
+const (
+	KindLord      FeastKind = "lord"
+	KindTheotokos FeastKind = "theotokos"
+)
+
 // Feast represents a fixed or moveable feast day.
 type Feast struct {
 	Name            string        `json:"name"`
 	GreekName       string        `json:"greek_name,omitempty"`
 	Description     string        `json:"description,omitempty"`
 	Rank            FeastRank     `json:"rank"`
+	Kind            FeastKind     `json:"kind,omitempty"`          // Set for feasts of the Lord and of the Theotokos
 	Month           *int          `json:"month,omitempty"`         // For fixed feasts
 	Day             *int          `json:"day,omitempty"`           // For fixed feasts
 	PaschaOffset    *int          `json:"pascha_offset,omitempty"` // For moveable feasts
+	Anchor          *FeastAnchor  `json:"anchor,omitempty"`        // For weekday-anchored feasts
 	FastingOverride *FastingLevel `json:"fasting_override,omitempty"`
+	PeriodName      string        `json:"period_name,omitempty"` // Short name for its periods, e.g. "the Transfiguration"
+	Forefeast       int           `json:"forefeast,omitempty"`   // Days of forefeast before the feast
+	Afterfeast      int           `json:"afterfeast,omitempty"`  // Days after the feast through its apodosis
+	TransferredFrom *Date         `json:"-"`                     // Set when a transfer rule moved the feast to this day
+}
+
+// AnchorRelation places a weekday-anchored feast relative to its fixed date.
+type AnchorRelation string
+
+const (
+	AnchorBefore  AnchorRelation = "before"  // The last such weekday strictly before the date
+	AnchorAfter   AnchorRelation = "after"   // The first such weekday strictly after the date
+	AnchorNearest AnchorRelation = "nearest" // The such weekday within three days of the date, or the date itself
+)
+
+// FeastAnchor defines a feast kept on a weekday around a fixed date, such as the
+// Sunday after the Elevation of the Cross.
+type FeastAnchor struct {
+	Month    int            `json:"month"` // Fixed date the feast is anchored to
+	Day      int            `json:"day"`
+	Weekday  time.Weekday   `json:"weekday"` // 0=Sunday .. 6=Saturday
+	Relation AnchorRelation `json:"relation"`
+}
+
+// FeastPeriodKind distinguishes the days surrounding a feast.
+type FeastPeriodKind string
+
+const (
+	PeriodForefeast  FeastPeriodKind = "forefeast"
+	PeriodAfterfeast FeastPeriodKind = "afterfeast"
+	PeriodApodosis   FeastPeriodKind = "apodosis" // Leave-taking: the last day of the afterfeast
+)
+
+// FeastPeriod places a day within the forefeast or afterfeast of a feast.
+type FeastPeriod struct {
+	Kind  FeastPeriodKind
+	Feast string // The feast's period name, e.g. "the Transfiguration"
+	Day   int    // Day within the period; the feast itself is day 1 of the afterfeast
+	Of    int    // Length of the period
+}
+
+// Title returns the period's liturgical title, e.g. "Afterfeast of the Transfiguration (day 3 of 8)".
+func (p FeastPeriod) Title() string {
+	switch p.Kind {
+	case PeriodForefeast:
+		if p.Of > 1 {
+			return fmt.Sprintf("Forefeast of %s (day %d of %d)", p.Feast, p.Day, p.Of)
+		}
+		return "Forefeast of " + p.Feast
+	case PeriodAfterfeast:
+		return fmt.Sprintf("Afterfeast of %s (day %d of %d)", p.Feast, p.Day, p.Of)
+	case PeriodApodosis:
+		return "Apodosis of " + p.Feast
+	default:
+		return p.Feast
+	}
+}
+
+// LiturgicalPeriod is a season of the liturgical year.
+type LiturgicalPeriod string
+
+const (
+	PeriodTriodion       LiturgicalPeriod = "triodion"        // Publican and Pharisee to Cheesefare Sunday
+	PeriodGreatLent      LiturgicalPeriod = "great_lent"      // Clean Monday to the Friday before Lazarus Saturday
+	PeriodHolyWeek       LiturgicalPeriod = "holy_week"       // Lazarus Saturday to Holy Saturday
+	PeriodPentecostarion LiturgicalPeriod = "pentecostarion"  // Pascha to Pentecost
+	PeriodAfterPentecost LiturgicalPeriod = "after_pentecost" // Monday of the Holy Spirit to the next Triodion
+)
+
+// LiturgicalDay names a day by its place in the moveable cycle.
+type LiturgicalDay struct {
+	Period LiturgicalPeriod
+	Week   int    // Week of the period (of Lent, after Pascha, after Pentecost); 0 in Holy Week
+	Title  string // e.g. "Tuesday of the 3rd Week of Lent", "14th Sunday of Luke"
+}
+
+// TransferAction is what a transfer rule does when a fixed feast meets the moveable cycle.
+type TransferAction string
+
+const (
+	TransferMove        TransferAction = "transfer"    // Celebrate the feast on another day
+	TransferMerge       TransferAction = "merge"       // Keep the feast; its services are combined with the day's
+	TransferCoincidence TransferAction = "coincidence" // Keep the feast and flag the special coincidence
+)
+
+// TransferRule governs a fixed feast that falls within a range of days around Pascha.
+type TransferRule struct {
+	Name               string         `json:"name"`
+	Month              int            `json:"month"` // Fixed date of the feast
+	Day                int            `json:"day"`
+	PaschaOffsetStart  int            `json:"pascha_offset_start"` // Days from Pascha the rule applies to
+	PaschaOffsetEnd    int            `json:"pascha_offset_end"`
+	Action             TransferAction `json:"action"`
+	TargetPaschaOffset *int           `json:"target_pascha_offset,omitempty"` // Destination of a "transfer"
+	Note               string         `json:"note,omitempty"`                 // Shown on the day for "merge" and "coincidence"
+	Description        string         `json:"description"`
+}
+
+// Memorial is a Saturday of Souls (Psychosabbaton), a day of general commemoration
+// of the departed kept relative to Pascha.
+type Memorial struct {
+	Name         string `json:"name"`
+	GreekName    string `json:"greek_name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	PaschaOffset int    `json:"pascha_offset"` // Days from Pascha
+}
+
+// Tone is a tone (echos) of the Octoechos: 1–4 are the authentic tones, 5–8 the
+// plagal tones (7 is the grave tone). Zero means no tone of the week.
+type Tone int
+
+// String returns the tone's Greek-usage name, e.g. "Plagal of the First Tone".
+func (t Tone) String() string {
+	switch t {
+	case 1, 2, 3, 4:
+		return fmt.Sprintf("Tone %d", int(t))
+	case 5:
+		return "Plagal of the First Tone"
+	case 6:
+		return "Plagal of the Second Tone"
+	case 7:
+		return "Grave Tone"
+	case 8:
+		return "Plagal of the Fourth Tone"
+	default:
+		return ""
+	}
+}
+
+// Short returns an abbreviated name, e.g. "Tone pl. 1".
+func (t Tone) Short() string {
+	switch t {
+	case 5, 6:
+		return fmt.Sprintf("Tone pl. %d", int(t)-4)
+	case 8:
+		return "Tone pl. 4"
+	default:
+		return t.String()
+	}
 }
 
 // Saint represents a commemorated saint on a given date.
@@ -83,6 +626,23 @@ type Saint struct {
 	Day         int    `json:"day"`
 }
 
+// NameDay groups the forms of a given name that share the same name days.
+// A name is celebrated on the days its commemorations are kept, so moveable
+// and transferred feasts carry their name days with them.
+type NameDay struct {
+	Name           string   `json:"name"`           // English form, e.g. "George"
+	GreekName      string   `json:"greek_name"`     // e.g. "Γεώργιος"
+	Variants       []string `json:"variants"`       // Other forms, e.g. "Giorgos", "Γιώργος", "Georgia"
+	Commemorations []string `json:"commemorations"` // Names of the feasts or saints whose days are name days
+}
+
+// Contact is a person from the user's address book.
+type Contact struct {
+	Name      string // Full name as shown, e.g. "Giorgos Papadopoulos"
+	GivenName string // e.g. "Giorgos"; may be empty
+	NameDay   string // Name of the matching NameDay, set when the contact is matched
+}
+
 // Quote represents a Church Father or saint's quote.
 type Quote struct {
 	Text   string `json:"text"`
@@ -100,16 +660,34 @@ type ScriptureReading struct {
 type DayReadings struct {
 	Epistle *ScriptureReading `json:"epistle,omitempty"`
 	Gospel  *ScriptureReading `json:"gospel,omitempty"`
-	Source  string            `json:"source,omitempty"` // "Cycle" or "Feast: Name"
+	Source  string            `json:"source,omitempty"` // "Cycle", "Feast" or "Matins"
 }
 
 // DayInfo is the composite result returned by GetDayInfo for display.
 type DayInfo struct {
-	Date          time.Time
-	Feasts        []Feast
-	Saints        []Saint
-	FastingLevel  FastingLevel
-	FastingReason string
-	Readings      []DayReadings
-	Quote         Quote
+	Date           Date // Civil (Gregorian) date
+	JulianDate     Date // The same day on the Julian calendar
+	Style          CalendarStyle
+	Feasts         []Feast
+	Saints         []Saint
+	NameDays       []NameDay
+	Contacts       []Contact // Contacts whose name day it is
+	FastingLevel   FastingLevel
+	FastingReason  string
+	PersonalFast   *PersonalFast // Set when a fasting profile is in use
+	FastingStatus  FastingStatus // Without NextFastFree; see Calendar.FastingStatus
+	Coincidences   []string      // Notes from merge and coincidence transfer rules
+	FeastPeriods   []FeastPeriod
+	Memorials      []Memorial // Saturdays of Souls
+	LiturgicalDay  LiturgicalDay
+	Liturgy        Liturgy
+	LiturgyNote    string // Why the day has its Liturgy, unless it is the ordinary one
+	Weddings       bool   // Whether marriages may be celebrated
+	WeddingReason  string // Why marriages are not celebrated, when they are not
+	Kneeling       Kneeling
+	KneelingReason string
+	Tone           Tone // Tone of the week, or of the day in Bright Week
+	Eothinon       int  // Sunday Matins Gospel, 1–11; 0 on weekdays and Sundays without one
+	Readings       []DayReadings
+	Quote          Quote
 }
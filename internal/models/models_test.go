@@ -0,0 +1,78 @@
+package models
+
+import "testing"
+
+func TestFastingLevels_Chain(t *testing.T) {
+	// The named levels relax one food category at a time.
+	for i := 1; i < len(FastingLevels); i++ {
+		a, b := FastingLevels[i-1], FastingLevels[i]
+		if !a.StricterThan(b) {
+			t.Errorf("%s should be stricter than %s", a, b)
+		}
+		if b.StricterThan(a) {
+			t.Errorf("%s should not be stricter than %s", b, a)
+		}
+	}
+}
+
+func TestFastingLevel_Permits(t *testing.T) {
+	tests := []struct {
+		level FastingLevel
+		food  Food
+		want  bool
+	}{
+		{FastingTotal, FoodDry, false},
+		{FastingXerophagy, FoodDry, true},
+		{FastingXerophagy, FoodCooked, false},
+		{FastingStrict, FoodShellfish, true},
+		{FastingStrict, FoodOil, false},
+		{FastingWine, FoodWine, true},
+		{FastingWine, FoodOil, false},
+		{FastingFish, FoodFish, true},
+		{FastingFish, FoodDairy, false},
+		{FastingDairyFish, FoodEggs, true},
+		{FastingDairyFish, FoodMeat, false},
+		{FastingNone, FoodMeat, true},
+	}
+	for _, tt := range tests {
+		foods, ok := tt.level.Permits()
+		if !ok {
+			t.Fatalf("%s: unknown level", tt.level)
+		}
+		if got := foods.Has(tt.food); got != tt.want {
+			t.Errorf("%s permits %s = %v, want %v", tt.level, tt.food, got, tt.want)
+		}
+	}
+}
+
+func TestFoodSet_PartialOrder(t *testing.T) {
+	fish := NewFoodSet(FoodOil, FoodWine, FoodFish)
+	dairy := NewFoodSet(FoodOil, FoodWine, FoodDairy)
+	if fish.SubsetOf(dairy) || dairy.SubsetOf(fish) {
+		t.Error("sets that each hold a food the other lacks should be incomparable")
+	}
+	if !NewFoodSet(FoodOil).SubsetOf(fish) {
+		t.Error("{oil} should be a subset of {oil, wine, fish}")
+	}
+	if NewFoodSet("bread") != 0 {
+		t.Error("unknown foods should be ignored")
+	}
+	if FastingLevel("feast").StricterThan(FastingNone) || FastingStrict.StricterThan("feast") {
+		t.Error("unknown levels should be incomparable")
+	}
+}
+
+func TestFastingCounts_Add(t *testing.T) {
+	var c FastingCounts
+	for _, l := range []FastingLevel{FastingTotal, FastingStrict, FastingWine, FastingOilWine, FastingFish, FastingDairyFish, FastingNone} {
+		c.Add(l)
+	}
+	want := FastingCounts{Days: 7, FastDays: 6, StrictDays: 2, FishDays: 2, WineDays: 4}
+	if c.Days != want.Days || c.FastDays != want.FastDays || c.StrictDays != want.StrictDays ||
+		c.FishDays != want.FishDays || c.WineDays != want.WineDays {
+		t.Errorf("counts = %+v, want %+v", c, want)
+	}
+	if c.Levels[FastingNone] != 1 || c.Levels[FastingXerophagy] != 0 {
+		t.Errorf("levels = %v", c.Levels)
+	}
+}
@@ -1,11 +1,46 @@
 package pascha
 
-import "time"
+import (
+	"greekOrtho/internal/models"
+	"time"
+)
 
-// Compute returns the date of Orthodox Pascha (Easter) for the given year.
-// Uses the Meeus Julian Easter algorithm and converts to the Gregorian calendar.
-func Compute(year int) time.Time {
-	// Meeus algorithm for Julian Easter
+// GregorianReform is the first full year of the Gregorian calendar. Before it,
+// East and West alike kept Easter by the Julian computus.
+const GregorianReform = 1583
+
+// keyLetters is the "key of boundaries" of the Slavonic Paschalion, one letter
+// per possible Julian date of Pascha from March 22 to April 25.
+var keyLetters = []string{
+	"А", "Б", "В", "Г", "Д", "Е", "Ж", "Ѕ", "З", "И", "І", "К",
+	"Л", "М", "Н", "О", "П", "Р", "С", "Т", "У", "Ф", "Х", "Ѿ",
+	"Ц", "Ч", "Ш", "Щ", "Ъ", "Ы", "Ь", "Ѣ", "Ю", "Ѫ", "Ѧ",
+}
+
+// Paschalion holds the computus keys and Pascha dates for a single year.
+type Paschalion struct {
+	Year         int
+	GoldenNumber int         // 1–19, the year's place in the 19-year lunar cycle
+	SolarCycle   int         // 1–28, counted from the Creation (Byzantine era)
+	Epact        int         // 0–29, Julian epact: age of the moon on March 22
+	Indiction    int         // 1–15
+	KeyLetter    string      // Key of boundaries: А (March 22 O.S.) to Ѧ (April 25 O.S.)
+	Julian       models.Date // Orthodox Pascha on the Julian calendar
+	Gregorian    models.Date // Orthodox Pascha on the Gregorian calendar
+	Western      models.Date // Western Easter on the Gregorian calendar
+}
+
+// Compute returns the date of Orthodox Pascha (Easter) for the given year on the
+// Gregorian calendar (proleptic for years before 1583).
+func Compute(year int) models.Date {
+	j := ComputeJulian(year)
+	return models.FromJulian(j.Year, j.Month, j.Day)
+}
+
+// ComputeJulian returns the date of Orthodox Pascha for the given year on the
+// Julian calendar, using the Meeus Julian Easter algorithm. The fields of the
+// result hold the Julian date.
+func ComputeJulian(year int) models.Date {
 	a := year % 4
 	b := year % 7
 	c := year % 19
@@ -14,14 +49,61 @@ func Compute(year int) time.Time {
 	month := (d + e + 114) / 31
 	day := ((d + e + 114) % 31) + 1
 
-	// This gives the Julian calendar date. Convert to Gregorian.
-	// The offset between Julian and Gregorian calendars:
-	// For years 1900-2099, the offset is 13 days.
-	// General formula: century = year/100; offset = century - century/4 - 2
-	century := year / 100
-	offset := century - century/4 - 2
+	return models.Date{Year: year, Month: time.Month(month), Day: day}
+}
+
+// ComputeWestern returns the date of Western (Catholic and Protestant) Easter for
+// the given year on the Gregorian calendar. Years before 1583 use the Julian
+// computus, which the West followed until the Gregorian reform.
+func ComputeWestern(year int) models.Date {
+	if year < GregorianReform {
+		return Compute(year)
+	}
+
+	// Anonymous Gregorian algorithm (Meeus/Jones/Butcher)
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := ((h + l - 7*m + 114) % 31) + 1
+
+	return models.NewDate(year, time.Month(month), day)
+}
+
+// Keys returns the full Paschalion entry for the given year.
+func Keys(year int) Paschalion {
+	// Years of the world (Anno Mundi) by the Byzantine era, as counted in spring.
+	am := year + 5508
+
+	julian := ComputeJulian(year)
+	boundary := models.FromJulian(year, julian.Month, julian.Day).Sub(models.FromJulian(year, time.March, 22))
+
+	return Paschalion{
+		Year:         year,
+		GoldenNumber: year%19 + 1,
+		SolarCycle:   cycle(am, 28),
+		Epact:        (11 * (year % 19)) % 30,
+		Indiction:    cycle(am, 15),
+		KeyLetter:    keyLetters[boundary],
+		Julian:       julian,
+		Gregorian:    Compute(year),
+		Western:      ComputeWestern(year),
+	}
+}
 
-	julian := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
-	gregorian := julian.AddDate(0, 0, offset)
-	return gregorian
+// cycle returns n's position (1..length) in a repeating cycle.
+func cycle(n, length int) int {
+	if r := n % length; r != 0 {
+		return r
+	}
+	return length
 }
@@ -1,6 +1,7 @@
 package pascha
 
 import (
+	"greekOrtho/internal/models"
 	"testing"
 	"time"
 )
@@ -9,21 +10,190 @@ func TestCompute(t *testing.T) {
 	// Known Orthodox Pascha dates (Gregorian)
 	tests := []struct {
 		year int
-		want time.Time
+		want models.Date
 	}{
-		{2024, time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC)},
-		{2025, time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC)},
-		{2026, time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC)},
-		{2027, time.Date(2027, 5, 2, 0, 0, 0, 0, time.UTC)},
-		{2028, time.Date(2028, 4, 16, 0, 0, 0, 0, time.UTC)},
-		{2029, time.Date(2029, 4, 8, 0, 0, 0, 0, time.UTC)},
-		{2030, time.Date(2030, 4, 28, 0, 0, 0, 0, time.UTC)},
+		{2024, models.NewDate(2024, 5, 5)},
+		{2025, models.NewDate(2025, 4, 20)},
+		{2026, models.NewDate(2026, 4, 12)},
+		{2027, models.NewDate(2027, 5, 2)},
+		{2028, models.NewDate(2028, 4, 16)},
+		{2029, models.NewDate(2029, 4, 8)},
+		{2030, models.NewDate(2030, 4, 28)},
 	}
 
 	for _, tt := range tests {
 		got := Compute(tt.year)
-		if !got.Equal(tt.want) {
-			t.Errorf("Compute(%d) = %s, want %s", tt.year, got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+		if got != tt.want {
+			t.Errorf("Compute(%d) = %s, want %s", tt.year, got, tt.want)
 		}
 	}
 }
+
+// Published Paschalion dates for Orthodox Pascha (Gregorian).
+var orthodoxTable = map[int]string{
+	2000: "2000-04-30",
+	2001: "2001-04-15",
+	2002: "2002-05-05",
+	2008: "2008-04-27",
+	2010: "2010-04-04",
+	2013: "2013-05-05",
+	2016: "2016-05-01",
+	2017: "2017-04-16",
+	2019: "2019-04-28",
+	2021: "2021-05-02",
+	2022: "2022-04-24",
+	2023: "2023-04-16",
+}
+
+// Published Western Easter dates, including the earliest and latest possible.
+var westernTable = map[int]string{
+	1583: "1583-04-10",
+	1818: "1818-03-22",
+	1886: "1886-04-25",
+	1943: "1943-04-25",
+	2000: "2000-04-23",
+	2008: "2008-03-23",
+	2010: "2010-04-04",
+	2013: "2013-03-31",
+	2019: "2019-04-21",
+	2024: "2024-03-31",
+	2025: "2025-04-20",
+	2038: "2038-04-25",
+	2285: "2285-03-22",
+}
+
+func TestPaschalionTable(t *testing.T) {
+	for year, want := range orthodoxTable {
+		if got := Compute(year).String(); got != want {
+			t.Errorf("Compute(%d) = %s, want %s", year, got, want)
+		}
+	}
+	for year, want := range westernTable {
+		if got := ComputeWestern(year).String(); got != want {
+			t.Errorf("ComputeWestern(%d) = %s, want %s", year, got, want)
+		}
+	}
+}
+
+// Paschalion dates of Orthodox Pascha (Gregorian) from the Gregorian reform to
+// 4099, at least one in each century, with the Julian date each is kept on.
+var orthodoxCenturies = []struct {
+	year      int
+	julian    string
+	gregorian string
+}{
+	{1583, "1583-03-31", "1583-04-10"},
+	{1600, "1600-03-23", "1600-04-02"},
+	{1700, "1700-03-31", "1700-04-11"},
+	{1800, "1800-04-08", "1800-04-20"},
+	{1900, "1900-04-09", "1900-04-22"},
+	{1917, "1917-04-02", "1917-04-15"},
+	{1945, "1945-04-23", "1945-05-06"},
+	{2100, "2100-04-18", "2100-05-02"},
+	{2200, "2200-03-22", "2200-04-06"},
+	{2300, "2300-03-30", "2300-04-15"},
+	{2400, "2400-03-31", "2400-04-16"},
+	{2500, "2500-04-08", "2500-04-25"},
+	{2600, "2600-04-16", "2600-05-04"},
+	{2700, "2700-04-17", "2700-05-06"},
+	{2800, "2800-04-25", "2800-05-14"},
+	{2900, "2900-03-29", "2900-04-18"},
+	{3000, "3000-03-30", "3000-04-20"},
+	{3100, "3100-04-07", "3100-04-29"},
+	{3200, "3200-04-08", "3200-04-30"},
+	{3300, "3300-04-16", "3300-05-09"},
+	{3400, "3400-04-24", "3400-05-18"},
+	{3500, "3500-03-28", "3500-04-22"},
+	{3600, "3600-03-29", "3600-04-23"},
+	{3700, "3700-04-06", "3700-05-02"},
+	{3800, "3800-04-07", "3800-05-04"},
+	{3900, "3900-04-15", "3900-05-13"},
+	{4000, "4000-04-16", "4000-05-14"},
+	{4099, "4099-04-05", "4099-05-03"},
+}
+
+func TestComputeRange1583To4099(t *testing.T) {
+	for _, tt := range orthodoxCenturies {
+		if got := ComputeJulian(tt.year).String(); got != tt.julian {
+			t.Errorf("ComputeJulian(%d) = %s, want %s", tt.year, got, tt.julian)
+		}
+		if got := Compute(tt.year).String(); got != tt.gregorian {
+			t.Errorf("Compute(%d) = %s, want %s", tt.year, got, tt.gregorian)
+		}
+	}
+
+	for year := GregorianReform; year <= 4099; year++ {
+		orthodox := Compute(year)
+		western := ComputeWestern(year)
+		julian := ComputeJulian(year)
+
+		if orthodox.Weekday() != time.Sunday {
+			t.Fatalf("%d: Orthodox Pascha %s is not a Sunday", year, orthodox)
+		}
+		if western.Weekday() != time.Sunday {
+			t.Fatalf("%d: Western Easter %s is not a Sunday", year, western)
+		}
+		if models.FromJulian(julian.Year, julian.Month, julian.Day) != orthodox {
+			t.Fatalf("%d: ComputeJulian and Compute disagree", year)
+		}
+
+		// Julian Pascha falls between March 22 and April 25 O.S.
+		jv := int(julian.Month)*100 + julian.Day
+		if jv < 322 || jv > 425 {
+			t.Fatalf("%d: Julian Pascha %s outside Mar 22–Apr 25", year, julian.Format("01-02"))
+		}
+		wv := int(western.Month)*100 + western.Day
+		if wv < 322 || wv > 425 {
+			t.Fatalf("%d: Western Easter %s outside Mar 22–Apr 25", year, western.Format("01-02"))
+		}
+
+		// The Julian Paschalion repeats every 532 years (the Great Indiction)
+		if year+532 <= 4099 {
+			next := ComputeJulian(year + 532)
+			if next.Month != julian.Month || next.Day != julian.Day {
+				t.Fatalf("%d: Julian Pascha %s differs from %d's %s", year,
+					julian.Format("01-02"), year+532, next.Format("01-02"))
+			}
+		}
+
+		// Orthodox Pascha never precedes Western Easter
+		if orthodox.Before(western) {
+			t.Fatalf("%d: Orthodox Pascha %s before Western Easter %s", year,
+				orthodox, western)
+		}
+	}
+}
+
+func TestComputeBeforeReform(t *testing.T) {
+	// Before 1583 both churches kept the Julian computus.
+	for _, year := range []int{325, 1054, 1453, 1582} {
+		if Compute(year) != ComputeWestern(year) {
+			t.Errorf("%d: expected Orthodox and Western Easter to coincide", year)
+		}
+	}
+
+	// Pascha of 1453 was April 1 O.S.
+	if got := ComputeJulian(1453).String(); got != "1453-04-01" {
+		t.Errorf("ComputeJulian(1453) = %s, want 1453-04-01", got)
+	}
+}
+
+func TestKeys(t *testing.T) {
+	k := Keys(2020)
+	if k.GoldenNumber != 7 {
+		t.Errorf("GoldenNumber(2020) = %d, want 7", k.GoldenNumber)
+	}
+	if k.SolarCycle != 24 {
+		t.Errorf("SolarCycle(2020) = %d, want 24", k.SolarCycle)
+	}
+	if k.Indiction != 13 {
+		t.Errorf("Indiction(2020) = %d, want 13", k.Indiction)
+	}
+	if k.Epact != 6 {
+		t.Errorf("Epact(2020) = %d, want 6", k.Epact)
+	}
+	// Pascha 2020 = April 6 O.S., the 16th day from March 22
+	if k.KeyLetter != "О" {
+		t.Errorf("KeyLetter(2020) = %s, want О", k.KeyLetter)
+	}
+}
@@ -4,18 +4,55 @@ import (
 	"flag"
 	"fmt"
 	"greekOrtho/internal/calendar"
+	"greekOrtho/internal/contacts"
 	"greekOrtho/internal/data"
 	"greekOrtho/internal/display"
 	"greekOrtho/internal/models"
 	"os"
-	"time"
+	"path/filepath"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "paschalion":
+			runPaschalion(os.Args[2:])
+			return
+		case "weddings":
+			runWeddings(os.Args[2:])
+			return
+		case "profiles":
+			runProfiles(os.Args[2:])
+			return
+		case "nameday":
+			runNameDay(os.Args[2:])
+			return
+		case "upcoming":
+			runUpcoming(os.Args[2:])
+			return
+		case "food":
+			runFood(os.Args[2:])
+			return
+		case "fasts":
+			runFasts(os.Args[2:])
+			return
+		case "stats":
+			runStats(os.Args[2:])
+			return
+		}
+	}
+
 	dateFlag := flag.String("date", "", "Date to display in YYYY-MM-DD format (defaults to today)")
 	simpleFlag := flag.Bool("simple", false, "One-liner output suitable for piping or status bars")
 	monthFlag := flag.Bool("month", false, "Show monthly calendar grid")
 	browseFlag := flag.Bool("browse", false, "Interactive calendar browser")
+	titleFlag := flag.Bool("title", false, "Show liturgical day names (e.g. \"4th Sunday of Luke\") in -simple and -month output")
+	weddingsFlag := flag.Bool("weddings", false, "Shade the days on which weddings may be celebrated in -month output")
+	loadCalendar := calendarFlags(flag.CommandLine)
+	contactsFlag := flag.String("contacts", "", "Address book (.vcf or .csv) whose name days to show (defaults to contacts.vcf or contacts.csv in $XDG_CONFIG_HOME/orthoCal)")
+	fastingFlag := flag.String("fasting", "", "Personal fasting profile (defaults to fasting.json in $XDG_CONFIG_HOME/orthoCal)")
+	jsonFlag := flag.Bool("json", false, "Print the day as JSON")
+	explainFlag := flag.Bool("explain", false, "Show how the day's fasting level was resolved from the fasting rules")
 	flag.Parse()
 
 	modeCount := 0
@@ -28,61 +65,157 @@ func main() {
 	if *browseFlag {
 		modeCount++
 	}
+	if *jsonFlag {
+		modeCount++
+	}
+	if *explainFlag {
+		modeCount++
+	}
 	if modeCount > 1 {
-		fmt.Fprintf(os.Stderr, "Error: --simple, --month, and --browse are mutually exclusive\n")
+		fmt.Fprintf(os.Stderr, "Error: --simple, --month, --browse, --json and --explain are mutually exclusive\n")
 		os.Exit(1)
 	}
 
-	var date time.Time
+	var date models.Date
 	if *dateFlag != "" {
 		var err error
-		date, err = time.Parse("2006-01-02", *dateFlag)
+		date, err = models.ParseDate(*dateFlag)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: invalid date format %q (use YYYY-MM-DD)\n", *dateFlag)
 			os.Exit(1)
 		}
 	} else {
-		date = time.Now()
-		// Normalize to midnight UTC for consistent behavior
-		date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+		date = models.Today()
 	}
 
-	d, err := data.Load()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading calendar data: %v\n", err)
-		os.Exit(1)
-	}
-
-	cal := calendar.New(d)
+	cal := loadCalendar()
+	cal.SetContacts(loadContacts(*contactsFlag, false))
+	cal.SetFastingProfile(loadFastingProfile(*fastingFlag))
 
 	switch {
 	case *browseFlag:
-		if err := display.Browse(cal.GetDayInfo, date); err != nil {
+		if err := display.Browse(cal, date); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
 
 	case *simpleFlag:
 		info := cal.GetDayInfo(date)
-		display.PrintSimple(info)
+		display.PrintSimple(info, *titleFlag)
 
-	case *monthFlag:
-		today := time.Now()
-		today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	case *explainFlag:
+		display.PrintFastingExplanation(cal.GetDayInfo(date), cal.ExplainFasting(date))
 
-		year, month, _ := date.Date()
-		firstOfMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
-		daysInMonth := firstOfMonth.AddDate(0, 1, -1).Day()
+	case *jsonFlag:
+		info := cal.GetDayInfo(date)
+		info.FastingStatus = cal.FastingStatus(date)
+		display.PrintJSON(info)
 
-		days := make([]models.DayInfo, daysInMonth)
-		for i := 0; i < daysInMonth; i++ {
-			d := firstOfMonth.AddDate(0, 0, i)
-			days[i] = cal.GetDayInfo(d)
-		}
-		display.PrintMonth(days, today)
+	case *monthFlag:
+		days := cal.Month(date)
+		display.PrintMonth(days, models.Today(), *titleFlag, *weddingsFlag)
 
 	default:
 		info := cal.GetDayInfo(date)
 		display.PrintDayInfo(info)
 	}
 }
+
+// parseStyle converts the -calendar flag to a calendar style.
+func parseStyle(flag string) (models.CalendarStyle, error) {
+	style := models.CalendarStyle(flag)
+	if style != models.StyleRevised && style != models.StyleJulian {
+		return "", fmt.Errorf("invalid calendar %q (use julian or revised)", flag)
+	}
+	return style, nil
+}
+
+// calendarFlags registers the -calendar, -profile and -data-dir flags on fs and
+// returns a function that, once fs is parsed, loads the calendar they select.
+// The function exits on error.
+func calendarFlags(fs *flag.FlagSet) func() *calendar.Calendar {
+	calendarFlag := fs.String("calendar", "revised", "Calendar for fixed feasts: revised (New Calendar) or julian (Old Calendar)")
+	profileFlag := fs.String("profile", "", "Jurisdiction profile to apply (see the profiles command)")
+	dataDirFlag := fs.String("data-dir", "", "Directory of overlay files merged with the built-in data (defaults to $XDG_CONFIG_HOME/orthoCal)")
+	return func() *calendar.Calendar {
+		style, err := parseStyle(*calendarFlag)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return calendar.New(loadData(*profileFlag, *dataDirFlag), style)
+	}
+}
+
+// loadData loads the calendar data with the given profile and the user's
+// overlay files from dataDir or, if that is empty, from the default user
+// directory when it exists. It exits on error.
+func loadData(profile, dataDir string) *data.CalendarData {
+	if dataDir == "" {
+		if dir := data.UserDir(); dir != "" {
+			if _, err := os.Stat(dir); err == nil {
+				dataDir = dir
+			}
+		}
+	}
+
+	d, err := data.LoadWithOverlays(profile, dataDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading calendar data: %v\n", err)
+		os.Exit(1)
+	}
+	return d
+}
+
+// loadContacts reads the address book at path or, if that is empty, the first
+// of contacts.Files found in the default user directory. Without either it
+// returns no contacts, or exits if required is set. It exits on error.
+func loadContacts(path string, required bool) []models.Contact {
+	if path == "" {
+		if dir := data.UserDir(); dir != "" {
+			for _, name := range contacts.Files {
+				if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+					path = filepath.Join(dir, name)
+					break
+				}
+			}
+		}
+	}
+	if path == "" {
+		if required {
+			fmt.Fprintf(os.Stderr, "Error: no address book found (use -contacts or put contacts.vcf in %s)\n", data.UserDir())
+			os.Exit(1)
+		}
+		return nil
+	}
+
+	cs, err := contacts.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading contacts: %v\n", err)
+		os.Exit(1)
+	}
+	return cs
+}
+
+// loadFastingProfile reads the fasting profile at path or, if that is empty,
+// data.FastingProfileFile in the default user directory. Without either it
+// returns nil. It exits on error.
+func loadFastingProfile(path string) *models.FastingProfile {
+	if path == "" {
+		if dir := data.UserDir(); dir != "" {
+			if _, err := os.Stat(filepath.Join(dir, data.FastingProfileFile)); err == nil {
+				path = filepath.Join(dir, data.FastingProfileFile)
+			}
+		}
+	}
+	if path == "" {
+		return nil
+	}
+
+	p, err := data.LoadFastingProfile(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading fasting profile: %v\n", err)
+		os.Exit(1)
+	}
+	return &p
+}